@@ -1,19 +1,137 @@
 package fynecharts
 
+import (
+	"math"
+)
+
+const (
+	defaultLogBase         = 10
+	defaultLinearThreshold = 1
+)
+
 type axis struct {
 	min, max, dataRange float64
-	normalizer          normalizer
+	// hasData is set once a value has been included, until then min and max are left as they were built.
+	hasData bool
+	// fixed is set when the chart fixes the range, which scales then leave alone.
+	fixed bool
+	// minPositive is the smallest positive value the axis includes, where a log scale starts when the range reaches zero or below.
+	minPositive float64
+	normalizer  normalizer
 }
 
 func (a axis) normalize(x float64) float32 {
 	return a.normalizer.normalize(a.min, a.max, x)
 }
 
+// contains is whether x lies on the axis, values beyond a fixed range are left off the plot.
+func (a axis) contains(x float64) bool {
+	return inRange(x, a.min, a.max)
+}
+
+// include widens the axis to cover v, the first value included sets both ends.
+func (a *axis) include(v float64) {
+	if !a.hasData {
+		a.min, a.max = v, v
+		a.hasData = true
+	}
+	a.min = math.Min(a.min, v)
+	a.max = math.Max(a.max, v)
+	if v > 0 && (a.minPositive == 0 || v < a.minPositive) {
+		a.minPositive = v
+	}
+}
+
+// fitTicks widens an axis that follows the data out to its first and last ticks, as ticks may be placed beyond the data,
+// and returns the ticks that fall within it, leaving out those beyond a fixed range.
+func (a *axis) fitTicks(ticks []float64) []float64 {
+	if !a.fixed && len(ticks) > 0 {
+		a.min = math.Min(a.min, ticks[0])
+		a.max = math.Max(a.max, ticks[len(ticks)-1])
+		a.dataRange = a.max - a.min
+	}
+	return ticksInRange(ticks, a.min, a.max)
+}
+
+// fit applies r to the range the data covers, see AxisRange.
+func (a *axis) fit(r AxisRange, suggestedTickCount int) {
+	a.fixed = r.fixed()
+	if a.fixed {
+		a.min, a.max = r.Min, r.Max
+		a.dataRange = a.max - a.min
+		return
+	}
+
+	if a.hasData && r.Padding > 0 {
+		pad := (a.max - a.min) * r.Padding / 100
+		// Padding never takes the axis across zero when the data stays to one side of it.
+		a.min = padTowardsZero(a.min, -pad)
+		a.max = padTowardsZero(a.max, pad)
+	}
+	if !r.ExcludeZero {
+		a.min = math.Min(a.min, 0)
+		a.max = math.Max(a.max, 0)
+	}
+	if a.hasData && a.min == a.max {
+		a.min--
+		a.max++
+	}
+	if r.Nice {
+		a.min, a.max = niceRange(a.min, a.max, suggestedTickCount)
+	}
+	a.dataRange = a.max - a.min
+}
+
+func padTowardsZero(v, pad float64) float64 {
+	padded := v + pad
+	if v >= 0 && padded < 0 || v <= 0 && padded > 0 {
+		return 0
+	}
+	return padded
+}
+
+// niceRange widens low and high out to multiples of a round step, chosen to give about suggestedTickCount ticks.
+func niceRange(low, high float64, suggestedTickCount int) (float64, float64) {
+	if high <= low {
+		return low, high
+	}
+	rough := (high - low) / float64(max(suggestedTickCount-1, 1))
+	magnitude := math.Pow10(int(math.Floor(math.Log10(rough))))
+	step := 10 * magnitude
+	for _, q := range []float64{1, 2, 2.5, 5} {
+		if q*magnitude >= rough {
+			step = q * magnitude
+			break
+		}
+	}
+	return math.Floor(low/step) * step, math.Ceil(high/step) * step
+}
+
+// AxisRange controls the range of a value axis, which otherwise covers the data and zero.
+type AxisRange struct {
+	// Min and Max fix the ends of the axis, ignoring the data and the other options. The axis follows the data while they are equal.
+	Min, Max float64
+	// ExcludeZero lets the axis start away from zero, so that data far from it is not squashed into a sliver.
+	// Bars then leave out their baseline too, and are cut off at the end of the axis.
+	ExcludeZero bool
+	// Nice widens the axis out to round numbers.
+	Nice bool
+	// Padding widens the axis by this percentage of the data range at each end.
+	Padding float64
+}
+
+func (r AxisRange) fixed() bool {
+	return r.Max > r.Min
+}
+
 type normalizer interface {
 	normalize(min, max, x float64) float32
 }
 
 var _ normalizer = linearNormalizer{}
+var _ normalizer = logNormalizer{}
+var _ normalizer = symlogNormalizer{}
+var _ normalizer = sqrtNormalizer{}
 
 type linearNormalizer struct {
 }
@@ -21,3 +139,230 @@ type linearNormalizer struct {
 func (ln linearNormalizer) normalize(min, max, x float64) float32 {
 	return float32((x - min) / (max - min))
 }
+
+// logNormalizer places values by their logarithm, values of zero and below sit at the bottom of the axis.
+type logNormalizer struct {
+}
+
+func (ln logNormalizer) normalize(min, max, x float64) float32 {
+	if x <= 0 {
+		return 0
+	}
+	return normalizeTransformed(math.Log, min, max, x)
+}
+
+// symlogNormalizer is logarithmic away from zero in both directions, and close to linear within threshold of zero,
+// so that zero and negative values can be shown.
+type symlogNormalizer struct {
+	base, threshold float64
+}
+
+func (sn symlogNormalizer) normalize(min, max, x float64) float32 {
+	return normalizeTransformed(sn.transform, min, max, x)
+}
+
+func (sn symlogNormalizer) transform(x float64) float64 {
+	return math.Copysign(math.Log1p(math.Abs(x)/sn.threshold)/math.Log(sn.base), x)
+}
+
+// sqrtNormalizer places values by their square root, keeping the sign of negative values.
+type sqrtNormalizer struct {
+}
+
+func (sn sqrtNormalizer) normalize(min, max, x float64) float32 {
+	return normalizeTransformed(func(v float64) float64 { return math.Copysign(math.Sqrt(math.Abs(v)), v) }, min, max, x)
+}
+
+func normalizeTransformed(transform func(float64) float64, min, max, x float64) float32 {
+	return float32((transform(x) - transform(min)) / (transform(max) - transform(min)))
+}
+
+type ScaleType int
+
+const (
+	ScaleLinear ScaleType = iota
+	// ScaleLog is logarithmic, it only shows positive values, so the axis starts at the smallest positive value.
+	ScaleLog
+	// ScaleSymlog is logarithmic on both sides of zero and linear within LinearThreshold of it.
+	ScaleSymlog
+	ScaleSqrt
+)
+
+// AxisScale is how values are spread along a value axis.
+type AxisScale struct {
+	Type ScaleType
+	// Base is the base of log and symlog scales, and where their ticks fall, 0 uses 10.
+	Base float64
+	// LinearThreshold is how far either side of zero a symlog scale stays linear, 0 uses 1.
+	LinearThreshold float64
+}
+
+func (s AxisScale) base() float64 {
+	if s.Base <= 1 {
+		return defaultLogBase
+	}
+	return s.Base
+}
+
+func (s AxisScale) linearThreshold() float64 {
+	if s.LinearThreshold <= 0 {
+		return defaultLinearThreshold
+	}
+	return s.LinearThreshold
+}
+
+func (s AxisScale) normalizer() normalizer {
+	switch s.Type {
+	case ScaleLog:
+		return logNormalizer{}
+	case ScaleSymlog:
+		return symlogNormalizer{base: s.base(), threshold: s.linearThreshold()}
+	case ScaleSqrt:
+		return sqrtNormalizer{}
+	default:
+		return linearNormalizer{}
+	}
+}
+
+// apply sets the axis normalizer for the scale. A log axis is widened out to whole powers of its base,
+// starting from the smallest positive value when the range reaches zero. A fixed log axis keeps its ends,
+// except one at zero or below, which starts from the power of the base below the smallest positive value instead.
+func (s AxisScale) apply(a *axis) {
+	a.normalizer = s.normalizer()
+	if s.Type != ScaleLog || a.fixed && a.min > 0 {
+		return
+	}
+	low := a.min
+	if low <= 0 {
+		low = a.minPositive
+		if low <= 0 {
+			low = 1
+		}
+	}
+	logBase := math.Log(s.base())
+	if a.fixed {
+		a.min = math.Pow(s.base(), math.Floor(math.Log(low)/logBase+1e-9))
+		if a.max <= a.min {
+			a.max = a.min * s.base()
+		}
+		a.dataRange = a.max - a.min
+		return
+	}
+	a.min = math.Pow(s.base(), math.Floor(math.Log(low)/logBase+1e-9))
+	a.max = math.Pow(s.base(), math.Ceil(math.Log(math.Max(a.max, low))/logBase-1e-9))
+	if a.max <= a.min {
+		a.max = a.min * s.base()
+	}
+	a.dataRange = a.max - a.min
+}
+
+// ticks generates the tick values for the axis using g when set, otherwise at powers of the base on log and symlog scales
+// and from the default generator on the others.
+func (s AxisScale) ticks(a axis, suggestedTickCount int, g TickGenerator) ([]float64, error) {
+	if g != nil {
+		return g.Ticks(a.min, a.max, suggestedTickCount)
+	}
+	switch s.Type {
+	case ScaleLog:
+		return logTicks(a.min, a.max, s.base(), suggestedTickCount), nil
+	case ScaleSymlog:
+		return symlogTicks(a.min, a.max, s.base(), s.linearThreshold(), suggestedTickCount), nil
+	}
+	return DefaultTickGenerator().Ticks(a.min, a.max, suggestedTickCount)
+}
+
+// logTicks returns the powers of base between low and high, which must be positive. Spans of many decades skip
+// decades to stay near suggestedTickCount, counting down from the highest power so that it is always labelled,
+// and spans with fewer than two powers add ticks at multiples within each decade.
+func logTicks(low, high, base float64, suggestedTickCount int) []float64 {
+	lowExp := int(math.Floor(math.Log(low)/math.Log(base) + 1e-9))
+	highExp := int(math.Ceil(math.Log(high)/math.Log(base) - 1e-9))
+	topExp := int(math.Floor(math.Log(high)/math.Log(base) + 1e-9))
+	decades := highExp - lowExp
+	stride := max(1, int(math.Ceil(float64(decades)/float64(max(suggestedTickCount, 1)))))
+
+	var ticks []float64
+	for exp := topExp; exp >= lowExp; exp -= stride {
+		if v := math.Pow(base, float64(exp)); inRange(v, low, high) {
+			ticks = append([]float64{v}, ticks...)
+		}
+	}
+	if len(ticks) >= 2 {
+		return ticks
+	}
+
+	ticks = nil
+	for exp := lowExp; exp <= highExp; exp++ {
+		decade := math.Pow(base, float64(exp))
+		for _, m := range subDecadeMultiples(base) {
+			if v := m * decade; inRange(v, low, high) {
+				ticks = append(ticks, v)
+			}
+		}
+	}
+	return ticks
+}
+
+// subDecadeMultiples are where ticks fall within a decade when there are too few decades to label,
+// 1, 2 and 5 for base 10 and every integer multiple for small bases.
+func subDecadeMultiples(base float64) []float64 {
+	if base == 10 {
+		return []float64{1, 2, 5}
+	}
+	multiples := []float64{1}
+	for m := 2.0; m < base; m++ {
+		multiples = append(multiples, m)
+	}
+	return multiples
+}
+
+// symlogTicks returns zero, when in range, and the signed powers of base beyond the linear threshold,
+// as those within it would crowd zero.
+func symlogTicks(low, high, base, threshold float64, suggestedTickCount int) []float64 {
+	var ticks []float64
+	if inRange(0, low, high) {
+		ticks = append(ticks, 0)
+	}
+
+	extent := math.Max(math.Abs(low), math.Abs(high))
+	lowExp := int(math.Floor(math.Log(threshold)/math.Log(base)+1e-9)) + 1
+	highExp := int(math.Floor(math.Log(extent)/math.Log(base) + 1e-9))
+	// Both signs may be shown, so allow for half the ticks on each side.
+	stride := max(1, int(math.Ceil(float64(highExp-lowExp+1)/float64(max(suggestedTickCount/2, 1)))))
+	for exp := lowExp; exp <= highExp; exp += stride {
+		v := math.Pow(base, float64(exp))
+		if inRange(-v, low, high) {
+			ticks = append([]float64{-v}, ticks...)
+		}
+		if inRange(v, low, high) {
+			ticks = append(ticks, v)
+		}
+	}
+	return ticks
+}
+
+// ticksInRange is the ticks between low and high.
+func ticksInRange(ticks []float64, low, high float64) []float64 {
+	var within []float64
+	for _, v := range ticks {
+		if inRange(v, low, high) {
+			within = append(within, v)
+		}
+	}
+	return within
+}
+
+func inRange(v, low, high float64) bool {
+	// Allow for rounding in math.Pow so ticks at the ends of the range are kept.
+	eps := (high - low) * 1e-9
+	return v >= low-eps && v <= high+eps
+}
+
+// AxisSide is the y-axis a series is measured against.
+type AxisSide int
+
+const (
+	AxisLeft AxisSide = iota
+	// AxisRight measures the series against a secondary axis drawn on the right of the plot, with its own range and ticks.
+	AxisRight
+)
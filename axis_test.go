@@ -0,0 +1,128 @@
+package fynecharts
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestLogTicksDecades(t *testing.T) {
+	ticks := logTicks(1, 1e6, 10, 4)
+	if !slices.Equal(ticks, []float64{1, 100, 1e4, 1e6}) {
+		t.Error("expected every other decade", ticks)
+	}
+}
+
+func TestLogTicksKeepTheTopDecade(t *testing.T) {
+	ticks := logTicks(1, 1e5, 10, 4)
+	if !slices.Equal(ticks, []float64{10, 1000, 1e5}) {
+		t.Error("expected the top decade to be labelled", ticks)
+	}
+}
+
+func TestLogTicksSubDecades(t *testing.T) {
+	ticks := logTicks(15, 600, 10, 4)
+	if !slices.Equal(ticks, []float64{20, 50, 100, 200, 500}) {
+		t.Error("expected ticks within the decades", ticks)
+	}
+}
+
+func TestLogScaleWidensToDecades(t *testing.T) {
+	a := axis{}
+	for _, v := range []float64{0, 3, 40, 500} {
+		a.include(v)
+	}
+	AxisScale{Type: ScaleLog}.apply(&a)
+	if a.min != 1 || a.max != 1000 {
+		t.Error("expected the axis to cover the decades around the positive values", a.min, a.max)
+	}
+	if a.normalize(0) != 0 || a.normalize(100) < 0.66 || a.normalize(100) > 0.67 {
+		t.Error("expected zero at the bottom and each decade an equal share", a.normalize(0), a.normalize(100))
+	}
+}
+
+func TestSymlogTicks(t *testing.T) {
+	ticks := symlogTicks(-100, 1000, 10, 1, 8)
+	if !slices.Equal(ticks, []float64{-100, -10, 0, 10, 100, 1000}) {
+		t.Error("unexpected symlog ticks", ticks)
+	}
+}
+
+func TestSymlogNormalizerIsSymmetric(t *testing.T) {
+	n := symlogNormalizer{base: 10, threshold: 1}
+	for _, v := range []float64{0.5, 1, 10, 1000} {
+		if low, high := n.normalize(-1000, 1000, -v), n.normalize(-1000, 1000, v); math.Abs(float64(low+high-1)) > 1e-6 {
+			t.Error("expected values either side of zero to mirror each other", v, low, high)
+		}
+	}
+}
+
+func TestSqrtNormalizer(t *testing.T) {
+	if scale := (sqrtNormalizer{}).normalize(0, 100, 25); scale != 0.5 {
+		t.Error("expected 25 halfway up an axis to 100", scale)
+	}
+}
+
+func TestFitIncludesZeroByDefault(t *testing.T) {
+	a := axis{}
+	a.include(980)
+	a.include(1020)
+	a.fit(AxisRange{}, 4)
+	if a.min != 0 || a.max != 1020 {
+		t.Error("expected the axis to reach down to zero", a.min, a.max)
+	}
+}
+
+func TestFitExcludeZeroWithPadding(t *testing.T) {
+	a := axis{}
+	a.include(980)
+	a.include(1020)
+	a.fit(AxisRange{ExcludeZero: true, Padding: 10, Nice: true}, 5)
+	if a.min != 960 || a.max != 1040 {
+		t.Error("expected the padded range widened to round numbers", a.min, a.max)
+	}
+}
+
+func TestFitPaddingStopsAtZero(t *testing.T) {
+	a := axis{}
+	a.include(1)
+	a.include(101)
+	a.fit(AxisRange{ExcludeZero: true, Padding: 5}, 4)
+	if a.min != 0 || a.max != 106 {
+		t.Error("expected the padding not to cross zero", a.min, a.max)
+	}
+}
+
+func TestFitFixedRange(t *testing.T) {
+	a := axis{}
+	a.include(5)
+	a.fit(AxisRange{Min: 10, Max: 20, Padding: 50}, 4)
+	AxisScale{Type: ScaleLog}.apply(&a)
+	if a.min != 10 || a.max != 20 {
+		t.Error("expected the fixed range to be kept", a.min, a.max)
+	}
+}
+
+func TestLogScaleRaisesFixedZeroMin(t *testing.T) {
+	a := axis{}
+	a.include(0)
+	a.include(30)
+	a.fit(AxisRange{Min: 0, Max: 1000}, 4)
+	AxisScale{Type: ScaleLog}.apply(&a)
+	if a.min != 10 || a.max != 1000 {
+		t.Error("expected the fixed range to start at the decade below the data", a.min, a.max)
+	}
+	if n := a.normalize(100); math.IsNaN(float64(n)) || n != 0.5 {
+		t.Error("expected positions within the axis", n)
+	}
+}
+
+func TestFixedRangeContains(t *testing.T) {
+	a := axis{normalizer: linearNormalizer{}}
+	a.include(-50)
+	a.include(150)
+	a.fit(AxisRange{Min: 0, Max: 100}, 4)
+	if a.contains(150) || a.contains(-50) || !a.contains(100) || a.normalize(150) != 1.5 {
+		t.Error("expected values beyond the fixed range to be placed off the axis", a.normalize(150))
+	}
+}
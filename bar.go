@@ -6,6 +6,7 @@ import (
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"image/color"
 )
 
 type bar struct {
@@ -15,18 +16,21 @@ type bar struct {
 	displayValue string
 	showValue    bool
 	pos          fyne.Position
+	color        color.Color
+	series       int
 	idx          int
-	onTouched    func(idx int)
+	onTouched    func(series, idx int)
 }
 
 func (b *bar) Tapped(event *fyne.PointEvent) {
 	if b.onTouched != nil {
-		b.onTouched(b.idx)
+		b.onTouched(b.series, b.idx)
 	}
 }
 
-func (b *bar) updateOnTouched(f func(idx int), idx int) {
+func (b *bar) updateOnTouched(f func(series, idx int), series, idx int) {
 	b.onTouched = f
+	b.series = series
 	b.idx = idx
 }
 
@@ -61,14 +65,14 @@ func (b *bar) Cursor() desktop.Cursor {
 func (b *bar) CreateRenderer() fyne.WidgetRenderer {
 	return &barRenderer{
 		b:       b,
-		rect:    canvas.NewRectangle(theme.PrimaryColor()),
+		rect:    canvas.NewRectangle(b.color),
 		wrapper: canvas.NewRectangle(theme.BackgroundColor()),
 		display: widget.NewLabel(b.displayValue),
 	}
 }
 
-func newBar(canvas fyne.Canvas, value string) *bar {
-	b := &bar{canvas: canvas, displayValue: value}
+func newBar(canvas fyne.Canvas, value string, color color.Color) *bar {
+	b := &bar{canvas: canvas, displayValue: value, color: color}
 	b.ExtendBaseWidget(b)
 	b.Refresh()
 
@@ -1,32 +1,64 @@
 package fynecharts
 
 import (
+	"fmt"
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/theme"
+	"image/color"
 	"math"
 )
 
+// BarSeries is a named set of values drawn as one bar per label, using Color when set.
+type BarSeries struct {
+	Name   string
+	Values []float64
+	Color  color.Color
+	// Axis binds the series to the right y-axis. Stacked series only stack on those on the same axis, the stacks
+	// for each axis standing side by side. Horizontal bars have no right axis and draw every series against the bottom one.
+	Axis AxisSide
+}
+
+type BarMode int
+
+const (
+	BarModeGrouped BarMode = iota
+	BarModeStacked
+	BarModeStackedPercent
+)
+
 type BarChart struct {
 	*BaseChart
 	canvas fyne.Canvas
 
-	data []float64
+	series   []BarSeries
+	mode     BarMode
+	baseline float64
 
 	barWidth float32
 
-	hoverFormat func(float64) string
-	onTouched   func(idx int)
+	hoverFormat     func(float64) string
+	onTouched       func(idx int)
+	onSeriesTouched func(series, idx int)
 }
 
 func (b *BarChart) CreateRenderer() fyne.WidgetRenderer {
 	bcr := b.BaseChart.CreateRenderer().(*baseChartRenderer)
-
-	return &barChartRenderer{barChart: b, baseChartRenderer: bcr}
+	br := &barChartRenderer{barChart: b, baseChartRenderer: bcr}
+	for side := range br.baselines {
+		br.baselines[side] = canvas.NewLine(theme.ForegroundColor())
+		br.baselines[side].StrokeWidth = 1
+	}
+	return br
 }
 
 func (b *BarChart) UpdateData(labels []string, data []float64) {
+	b.UpdateSeries(labels, []BarSeries{{Values: data}})
+}
+
+func (b *BarChart) UpdateSeries(labels []string, series []BarSeries) {
 	b.xLabels = labels
-	b.data = data
+	b.series = series
 	b.Refresh()
 }
 
@@ -35,6 +67,24 @@ func (b *BarChart) SetBarWidth(w float32) {
 	b.Refresh()
 }
 
+// SetOrientation lays the bars out vertically or horizontally. Horizontal charts have no right axis,
+// series bound to it are drawn against the bottom axis with the rest.
+func (b *BarChart) SetOrientation(orientation Orientation) {
+	b.orientation = orientation
+	b.Refresh()
+}
+
+// SetBaseline sets the value bars grow from, bars below it extend downwards (or leftwards when horizontal).
+func (b *BarChart) SetBaseline(baseline float64) {
+	b.baseline = baseline
+	b.Refresh()
+}
+
+func (b *BarChart) SetBarMode(mode BarMode) {
+	b.mode = mode
+	b.Refresh()
+}
+
 func (b *BarChart) UpdateHoverFormat(f func(float642 float64) string) {
 	b.hoverFormat = f
 }
@@ -44,24 +94,91 @@ func (b *BarChart) UpdateOnTouched(f func(idx int)) {
 	b.Refresh()
 }
 
+func (b *BarChart) UpdateOnSeriesTouched(f func(series, idx int)) {
+	b.onSeriesTouched = f
+	b.Refresh()
+}
+
+func (b *BarChart) touched(series, idx int) {
+	if b.onTouched != nil {
+		b.onTouched(idx)
+	}
+	if b.onSeriesTouched != nil {
+		b.onSeriesTouched(series, idx)
+	}
+}
+
+func (b *BarChart) hoverValue(series int, value, total float64) string {
+	display := b.hoverFormat(value)
+	switch b.mode {
+	case BarModeStacked:
+		display = fmt.Sprintf("%s (total %s)", display, b.hoverFormat(total))
+	case BarModeStackedPercent:
+		display = fmt.Sprintf("%s (%.1f%% of %s)", display, percentOf(value, total), b.hoverFormat(total))
+	}
+	if name := b.series[series].Name; name != "" {
+		display = name + ": " + display
+	}
+	return display
+}
+
 func NewBarChart(canvas fyne.Canvas, title string, labels []string, data []float64) *BarChart {
-	bc := &BarChart{BaseChart: newBaseChart(title, labels, defaultMinHeight, defaultSuggestedTickCount),
+	return NewGroupedBarChart(canvas, title, labels, []BarSeries{{Values: data}})
+}
+
+func NewGroupedBarChart(canvas fyne.Canvas, title string, labels []string, series []BarSeries) *BarChart {
+	bc := newBarChart(canvas, title, labels, series)
+	bc.ExtendBaseWidget(bc)
+	bc.Refresh()
+
+	return bc
+}
+
+func newBarChart(canvas fyne.Canvas, title string, labels []string, series []BarSeries) *BarChart {
+	return &BarChart{BaseChart: newBaseChart(title, labels, defaultMinHeight, defaultSuggestedTickCount),
 		canvas:      canvas,
-		data:        data,
+		series:      series,
 		barWidth:    defaultBarWidth,
 		hoverFormat: defaultHoverFormat,
 	}
-	bc.ExtendBaseWidget(bc)
-	bc.Refresh()
+}
 
-	return bc
+// columnTotal sums the values at idx of every series on side, using absolute values when abs is set.
+func (b *barChartRenderer) columnTotal(idx int, side AxisSide, abs bool) float64 {
+	total := 0.0
+	for seriesIdx, series := range b.barChart.series {
+		if idx >= len(series.Values) || b.seriesAxis(seriesIdx) != side {
+			continue
+		}
+		if abs {
+			total += math.Abs(series.Values[idx])
+		} else {
+			total += series.Values[idx]
+		}
+	}
+	return total
+}
+
+func percentOf(value, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return value / total * 100
+}
+
+// barSpan is the value range covered by a single bar or stacked segment.
+type barSpan struct {
+	low, high float64
 }
 
 type barChartRenderer struct {
 	*baseChartRenderer
 	barChart *BarChart
 
-	data []*bar
+	data  [][]*bar
+	spans [][]barSpan
+	// baselines mark where the bars on each axis start, indexed by AxisSide.
+	baselines [2]*canvas.Line
 }
 
 func (b *barChartRenderer) Destroy() {
@@ -72,26 +189,126 @@ func (b *barChartRenderer) Layout(size fyne.Size) {
 	b.baseChartRenderer.Layout(size)
 
 	xOffset := b.xOffset()
+	if b.horizontal() {
+		b.layoutHorizontal(size, xOffset)
+		return
+	}
 
 	availableHeight := b.availableHeight(size)
 	columnWidth := b.columnWidth(size, xOffset)
+	barWidth := b.groupBarWidth(columnWidth)
+	groupWidth := barWidth * float32(b.barsPerColumn())
 
 	reqBottom := b.requiredBottomHeight()
-	if len(b.data) > 0 {
-		for idx, d := range b.barChart.data {
-			br := b.data[idx]
-			scale := b.yAxis.normalize(d)
-			brSize := fyne.NewSize(b.barChart.barWidth, availableHeight*scale)
+	for side, baseline := range b.baselines {
+		if side == int(AxisRight) && b.y2Axis == nil {
+			continue
+		}
+		baselineY := size.Height - reqBottom - availableHeight*b.valueAxis(AxisSide(side)).normalize(b.stackBase())
+		baseline.Position1 = fyne.NewPos(xOffset, baselineY)
+		baseline.Position2 = fyne.NewPos(xOffset+b.availableWidth(size, xOffset), baselineY)
+	}
+	for seriesIdx, bars := range b.data {
+		valueAxis := b.valueAxis(b.seriesAxis(seriesIdx))
+		for idx, br := range bars {
+			span := b.spans[seriesIdx][idx]
+			lowScale := clipScale(valueAxis.normalize(span.low))
+			highScale := clipScale(valueAxis.normalize(span.high))
+			brSize := fyne.NewSize(barWidth, availableHeight*(highScale-lowScale))
 			br.Resize(brSize)
 			xCellOffset := float32(idx) * columnWidth
-			rectPos := fyne.NewPos(xOffset+xCellOffset+columnWidth/2-br.Size().Width/2,
-				size.Height-reqBottom-(availableHeight*scale))
+			barX := xOffset + xCellOffset + columnWidth/2 - groupWidth/2
+			if b.barChart.mode == BarModeGrouped {
+				barX += float32(seriesIdx) * barWidth
+			} else if b.seriesAxis(seriesIdx) == AxisRight {
+				barX += barWidth
+			}
+			rectPos := fyne.NewPos(barX, size.Height-reqBottom-(availableHeight*highScale))
 			br.Move(rectPos)
 		}
 	}
 }
 
+func (b *barChartRenderer) layoutHorizontal(size fyne.Size, xOffset float32) {
+	availableWidth := b.availableWidth(size, xOffset)
+	rowHeight := b.rowHeight(size)
+	barHeight := b.groupBarWidth(rowHeight)
+	groupHeight := barHeight * float32(b.barsPerColumn())
+
+	top := b.requiredTopHeight()
+	baselineX := xOffset + availableWidth*b.yAxis.normalize(b.stackBase())
+	b.baselines[AxisLeft].Position1 = fyne.NewPos(baselineX, top)
+	b.baselines[AxisLeft].Position2 = fyne.NewPos(baselineX, size.Height-b.requiredBottomHeight())
+	for seriesIdx, bars := range b.data {
+		for idx, br := range bars {
+			span := b.spans[seriesIdx][idx]
+			lowScale := clipScale(b.yAxis.normalize(span.low))
+			highScale := clipScale(b.yAxis.normalize(span.high))
+			br.Resize(fyne.NewSize(availableWidth*(highScale-lowScale), barHeight))
+			yCellOffset := float32(idx) * rowHeight
+			barY := top + yCellOffset + rowHeight/2 - groupHeight/2
+			if b.barChart.mode == BarModeGrouped {
+				barY += float32(seriesIdx) * barHeight
+			}
+			br.Move(fyne.NewPos(xOffset+availableWidth*lowScale, barY))
+		}
+	}
+}
+
+// clipScale holds a bar end within the plot, so bars running beyond a fixed range or from an excluded baseline
+// are cut off at the end of the axis.
+func clipScale(scale float32) float32 {
+	return max(0, min(scale, 1))
+}
+
+// groupBarWidth shrinks the configured bar width when a group of bars would overflow its column,
+// down to a pixel wide so that bars in a column narrower than the padding are still drawn.
+func (b *barChartRenderer) groupBarWidth(columnWidth float32) float32 {
+	return fyne.Max(fyne.Min(b.barChart.barWidth, (columnWidth-theme.Padding())/float32(b.barsPerColumn())), 1)
+}
+
+// stackBase is the value bars and stacks start from, percentages always start from zero.
+func (b *barChartRenderer) stackBase() float64 {
+	if b.barChart.mode == BarModeStackedPercent {
+		return 0
+	}
+	return b.barChart.baseline
+}
+
+// seriesAxis is the y-axis the idx'th series is drawn against, horizontal bars all share the bottom axis.
+// Charts building their bars without series, like the waterfall, use the left axis.
+func (b *barChartRenderer) seriesAxis(idx int) AxisSide {
+	if b.horizontal() || idx >= len(b.barChart.series) {
+		return AxisLeft
+	}
+	return b.barChart.series[idx].Axis
+}
+
+// usesRightAxis is whether any series is drawn against the right axis.
+func (b *barChartRenderer) usesRightAxis() bool {
+	for idx := range b.barChart.series {
+		if b.seriesAxis(idx) == AxisRight {
+			return true
+		}
+	}
+	return false
+}
+
+// barsPerColumn is a bar per series when grouped, otherwise a stack per axis.
+func (b *barChartRenderer) barsPerColumn() int {
+	switch {
+	case b.barChart.mode == BarModeGrouped && len(b.barChart.series) > 0:
+		return len(b.barChart.series)
+	case b.barChart.mode != BarModeGrouped && b.usesRightAxis():
+		return 2
+	}
+	return 1
+}
+
 func (b *barChartRenderer) MinSize() fyne.Size {
+	if b.horizontal() {
+		return b.horizontalMinSize()
+	}
 	titleSize := fyne.NewSize(0, 0)
 	paddingCount := 0
 	if b.titleLbl.Visible() {
@@ -106,33 +323,92 @@ func (b *barChartRenderer) MinSize() fyne.Size {
 	}
 
 	xLblWidth := b.xLblMax.Width + 2
-	xCellWidth := b.barChart.barWidth + 2
+	xCellWidth := b.barChart.barWidth*float32(b.barsPerColumn()) + 2
 	return fyne.NewSize(float32(len(b.barChart.xLabels))*fyne.Max(xLblWidth, xCellWidth),
 		titleSize.Height+xLblSize.Height+b.xLblMax.Height+float32(paddingCount)*theme.Padding()+b.barChart.minHeight)
 }
 
+func (b *barChartRenderer) horizontalMinSize() fyne.Size {
+	xRowHeight := fyne.Max(b.xLblMax.Height, b.barChart.barWidth*float32(b.barsPerColumn())) + 2
+	return fyne.NewSize(b.xOffset()+b.yLblMax.Width+theme.Padding()+b.barChart.minHeight,
+		b.requiredTopHeight()+b.requiredBottomHeight()+float32(len(b.barChart.xLabels))*xRowHeight)
+}
+
 func (b *barChartRenderer) Objects() []fyne.CanvasObject {
 	cos := b.baseChartRenderer.Objects()
-	for _, d := range b.data {
-		cos = append(cos, d)
+	for _, bars := range b.data {
+		for _, d := range bars {
+			cos = append(cos, d)
+		}
 	}
+	cos = append(cos, b.baselines[AxisLeft], b.baselines[AxisRight])
 	return cos
 }
 
 func (b *barChartRenderer) Refresh() {
+	base := b.stackBase()
+	// Bars grow from the base, so it is in range unless zero is excluded, when bars are clipped at the end of the axis.
+	excludeBase := [2]bool{AxisLeft: b.barChart.yRange.ExcludeZero, AxisRight: b.barChart.y2Range.ExcludeZero}
 	b.yAxis = axis{normalizer: linearNormalizer{}}
+	if !excludeBase[AxisLeft] {
+		b.yAxis.include(base)
+	}
+	b.y2Axis = nil
+	if b.usesRightAxis() && !excludeBase[AxisRight] {
+		b.valueAxis(AxisRight).include(base)
+	}
 	/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
 	//for _, br := range b.data {
 	//	br.Hide()
 	//}
 	b.data = nil
-	for idx, datum := range b.barChart.data {
-		b.yAxis.max = math.Max(b.yAxis.max, datum)
-		b.yAxis.min = math.Min(b.yAxis.min, datum)
+	b.spans = nil
+	// Each axis has its own stacks, indexed by AxisSide.
+	var positiveStacks, negativeStacks [2][]float64
+	for seriesIdx, series := range b.barChart.series {
+		c := defaultSeriesColor(seriesIdx, series.Color)
+		var bars []*bar
+		var spans []barSpan
+		side := b.seriesAxis(seriesIdx)
+		valueAxis := b.valueAxis(side)
+		positiveStack, negativeStack := positiveStacks[side], negativeStacks[side]
+		for idx, datum := range series.Values {
+			total := 0.0
+			span := barSpan{low: math.Min(base, datum), high: math.Max(base, datum)}
+			switch b.barChart.mode {
+			case BarModeStacked, BarModeStackedPercent:
+				if idx >= len(positiveStack) {
+					positiveStack = append(positiveStack, base)
+					negativeStack = append(negativeStack, base)
+				}
+				// Percentages are of the absolute total, so the hover shows that total too.
+				total = b.columnTotal(idx, side, b.barChart.mode == BarModeStackedPercent)
+				value := datum
+				if b.barChart.mode == BarModeStackedPercent {
+					value = percentOf(datum, total)
+				}
+				if value >= 0 {
+					span = barSpan{low: positiveStack[idx], high: positiveStack[idx] + value}
+					positiveStack[idx] = span.high
+				} else {
+					span = barSpan{low: negativeStack[idx] + value, high: negativeStack[idx]}
+					negativeStack[idx] = span.low
+				}
+			}
+			for _, end := range []float64{span.low, span.high} {
+				if end != base || !excludeBase[side] {
+					valueAxis.include(end)
+				}
+			}
 
-		br := newBar(b.barChart.canvas, b.barChart.hoverFormat(datum))
-		br.updateOnTouched(b.barChart.onTouched, idx)
-		b.data = append(b.data, br)
+			br := newBar(b.barChart.canvas, b.barChart.hoverValue(seriesIdx, datum, total), c)
+			br.updateOnTouched(b.barChart.touched, seriesIdx, idx)
+			bars = append(bars, br)
+			spans = append(spans, span)
+		}
+		positiveStacks[side], negativeStacks[side] = positiveStack, negativeStack
+		b.data = append(b.data, bars)
+		b.spans = append(b.spans, spans)
 
 		/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
 		//if idx >= len(b.data) {
@@ -145,7 +421,25 @@ func (b *barChartRenderer) Refresh() {
 		//	b.data[idx].Show()
 		//}
 	}
-	b.yAxis.dataRange = b.yAxis.max - b.yAxis.min
+	b.updateDataRanges()
 
 	b.baseChartRenderer.Refresh()
+	b.refreshBaselines(base)
+}
+
+// refreshBaselines shows the baseline of each axis that has bars on it, while the base is within its range.
+func (b *barChartRenderer) refreshBaselines(base float64) {
+	var used [2]bool
+	for idx := range b.barChart.series {
+		used[b.seriesAxis(idx)] = true
+	}
+	// Charts drawing their bars without series, like the waterfall, use the left axis.
+	used[AxisLeft] = used[AxisLeft] || len(b.barChart.series) == 0
+	for side, baseline := range b.baselines {
+		if used[side] && b.valueAxis(AxisSide(side)).contains(base) {
+			baseline.Show()
+		} else {
+			baseline.Hide()
+		}
+	}
 }
@@ -0,0 +1,108 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"testing"
+)
+
+func TestBarChartRightAxisRange(t *testing.T) {
+	bc := NewGroupedBarChart(nil, "", []string{"a", "b"}, []BarSeries{
+		{Values: []float64{1, 2}},
+		{Values: []float64{100, 200}, Axis: AxisRight},
+	})
+	br := bc.CreateRenderer().(*barChartRenderer)
+	br.Refresh()
+
+	if br.yAxis.max != 2 {
+		t.Error("expected the left axis to only cover the left series", br.yAxis.min, br.yAxis.max)
+	}
+	if br.y2Axis == nil || br.y2Axis.max != 200 {
+		t.Fatal("expected the right axis to cover the right series", br.y2Axis)
+	}
+	if br.y2Axis.normalize(200) != 1 || br.yAxis.normalize(2) != 1 {
+		t.Error("expected each series to reach the top of its own axis")
+	}
+}
+
+func TestBarChartStacksPerAxis(t *testing.T) {
+	bc := NewGroupedBarChart(nil, "", []string{"a"}, []BarSeries{
+		{Values: []float64{1}},
+		{Values: []float64{2}},
+		{Values: []float64{100}, Axis: AxisRight},
+	})
+	bc.SetBarMode(BarModeStacked)
+	br := bc.CreateRenderer().(*barChartRenderer)
+	br.Refresh()
+
+	if br.yAxis.max != 3 || br.y2Axis.max != 100 {
+		t.Error("expected each axis to cover its own stack", br.yAxis.max, br.y2Axis.max)
+	}
+	if span := br.spans[2][0]; span.low != 0 || span.high != 100 {
+		t.Error("expected the right series to start its own stack", span)
+	}
+	if br.barsPerColumn() != 2 {
+		t.Error("expected a stack per axis", br.barsPerColumn())
+	}
+}
+
+func TestHorizontalBarChartHasNoRightAxis(t *testing.T) {
+	bc := NewGroupedBarChart(nil, "", []string{"a"}, []BarSeries{
+		{Values: []float64{1}},
+		{Values: []float64{100}, Axis: AxisRight},
+	})
+	bc.SetOrientation(OrientationHorizontal)
+	br := bc.CreateRenderer().(*barChartRenderer)
+	br.Refresh()
+
+	if br.y2Axis != nil || br.yAxis.max != 100 {
+		t.Error("expected every series on the bottom axis", br.y2Axis, br.yAxis.max)
+	}
+}
+
+func TestBarChartExcludeZero(t *testing.T) {
+	bc := NewBarChart(nil, "", []string{"a", "b"}, []float64{980, 1020})
+	bc.SetYRange(AxisRange{ExcludeZero: true})
+	br := bc.CreateRenderer().(*barChartRenderer)
+	br.Refresh()
+
+	if br.yAxis.min != 980 || br.yAxis.max != 1020 {
+		t.Error("expected the axis to leave out the baseline", br.yAxis.min, br.yAxis.max)
+	}
+	if clipScale(br.yAxis.normalize(br.spans[0][0].low)) != 0 || br.baselines[AxisLeft].Visible() {
+		t.Error("expected the bars to be clipped at the bottom of the axis and the baseline hidden")
+	}
+}
+
+func TestBarChartBaselineFollowsTheRightAxis(t *testing.T) {
+	test.NewApp()
+	bc := NewGroupedBarChart(nil, "", []string{"a", "b"}, []BarSeries{{Values: []float64{-50, 150}, Axis: AxisRight}})
+	br := test.WidgetRenderer(bc).(*barChartRenderer)
+	br.Refresh()
+	br.Layout(fyne.NewSize(400, 300))
+
+	if br.baselines[AxisLeft].Visible() || !br.baselines[AxisRight].Visible() {
+		t.Fatal("expected only the right axis baseline")
+	}
+	// The positive bar starts on the baseline.
+	up := br.data[0][1]
+	if y := br.baselines[AxisRight].Position1.Y; y != up.Position().Y+up.Size().Height {
+		t.Error("expected the baseline where the right axis bars start", y, up.Position().Y+up.Size().Height)
+	}
+}
+
+func TestBarChartGroupBarWidthInNarrowColumns(t *testing.T) {
+	bc := NewGroupedBarChart(nil, "", []string{"a"}, []BarSeries{
+		{Values: []float64{1}},
+		{Values: []float64{2}},
+		{Values: []float64{3}},
+	})
+	br := bc.CreateRenderer().(*barChartRenderer)
+
+	if w := br.groupBarWidth(1000); w != bc.barWidth {
+		t.Error("expected the configured width when the column has room", w)
+	}
+	if w := br.groupBarWidth(0); w != 1 {
+		t.Error("expected bars at least a pixel wide in a column narrower than the padding", w)
+	}
+}
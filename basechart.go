@@ -6,21 +6,39 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"log"
+	"time"
+)
+
+type Orientation int
+
+const (
+	OrientationVertical Orientation = iota
+	OrientationHorizontal
 )
 
 type BaseChart struct {
 	widget.BaseWidget
 
+	orientation Orientation
+
 	title   string
 	yTitle  string
+	y2Title string
 	xTitle  string
 	xLabels []string
 
 	suggestedTickCount int
 
+	yScale, y2Scale AxisScale
+	yRange, y2Range AxisRange
+
+	tickGenerator, xTickGenerator TickGenerator
+
 	minHeight float32
 
-	tickFormat func(input float64) string
+	tickFormat   func(input float64) string
+	y2TickFormat func(input float64) string
+	xTickFormat  func(input float64) string
 }
 
 func (b *BaseChart) CreateRenderer() fyne.WidgetRenderer {
@@ -32,6 +50,11 @@ func (b *BaseChart) CreateRenderer() fyne.WidgetRenderer {
 	yLbl.Hide()
 	ySep := canvas.NewLine(theme.ForegroundColor())
 	ySep.StrokeWidth = 2
+	y2Lbl := canvas.NewText(b.y2Title, theme.ForegroundColor())
+	y2Lbl.TextSize = theme.TextSize() + 3
+	y2Lbl.Hide()
+	y2Sep := canvas.NewLine(theme.ForegroundColor())
+	y2Sep.StrokeWidth = 2
 	xLbl := canvas.NewText(b.xTitle, theme.ForegroundColor())
 	xLbl.TextSize = theme.TextSize() + 3
 	xLbl.Hide()
@@ -39,17 +62,21 @@ func (b *BaseChart) CreateRenderer() fyne.WidgetRenderer {
 	xSep.StrokeWidth = 2
 
 	return &baseChartRenderer{baseChart: b,
-		titleLbl:        titleLbl,
-		yLbl:            yLbl,
-		ySeparator:      ySep,
-		xLbl:            xLbl,
-		xSeparator:      xSep,
-		yLabelPositions: make(map[*widget.Label]float64),
+		titleLbl:         titleLbl,
+		yLbl:             yLbl,
+		ySeparator:       ySep,
+		y2Lbl:            y2Lbl,
+		y2Separator:      y2Sep,
+		xLbl:             xLbl,
+		xSeparator:       xSep,
+		yLabelPositions:  make(map[*widget.Label]float64),
+		y2LabelPositions: make(map[*widget.Label]float64),
+		xLabelPositions:  make(map[*widget.Label]float64),
 	}
 }
 
 func newBaseChart(title string, xLabels []string, minHeight float32, suggestedTickCount int) *BaseChart {
-	bc := &BaseChart{title: title, xLabels: xLabels, minHeight: minHeight, suggestedTickCount: suggestedTickCount, tickFormat: defaultTickFormat}
+	bc := &BaseChart{title: title, xLabels: xLabels, minHeight: minHeight, suggestedTickCount: suggestedTickCount, tickFormat: defaultTickFormat, y2TickFormat: defaultTickFormat, xTickFormat: defaultTickFormat}
 
 	return bc
 }
@@ -64,6 +91,47 @@ func (b *BaseChart) SetYLabel(yLbl string) {
 	b.Refresh()
 }
 
+// SetY2Label sets the title of the right y-axis, which is only drawn when a series is bound to it.
+func (b *BaseChart) SetY2Label(y2Lbl string) {
+	b.y2Title = y2Lbl
+	b.Refresh()
+}
+
+// SetYScale sets how values are spread along the y-axis, such as logarithmically for data spanning many magnitudes.
+func (b *BaseChart) SetYScale(scale AxisScale) {
+	b.yScale = scale
+	b.Refresh()
+}
+
+func (b *BaseChart) SetY2Scale(scale AxisScale) {
+	b.y2Scale = scale
+	b.Refresh()
+}
+
+// SetYRange sets how the y-axis range is worked out from the data, or fixes it.
+func (b *BaseChart) SetYRange(r AxisRange) {
+	b.yRange = r
+	b.Refresh()
+}
+
+func (b *BaseChart) SetY2Range(r AxisRange) {
+	b.y2Range = r
+	b.Refresh()
+}
+
+// SetTickGenerator picks the ticks of the value axes, passing nil restores the default Talbot ticks,
+// or the powers of the base on log and symlog scales.
+func (b *BaseChart) SetTickGenerator(g TickGenerator) {
+	b.tickGenerator = g
+	b.Refresh()
+}
+
+// SetXTickGenerator picks the ticks of a numeric x-axis, passing nil restores the default.
+func (b *BaseChart) SetXTickGenerator(g TickGenerator) {
+	b.xTickGenerator = g
+	b.Refresh()
+}
+
 func (b *BaseChart) SetMinHeight(h float32) {
 	b.minHeight = h
 }
@@ -76,23 +144,60 @@ func (b *BaseChart) UpdateTickFormat(f func(input float64) string) {
 	b.tickFormat = f
 }
 
+func (b *BaseChart) UpdateY2TickFormat(f func(input float64) string) {
+	b.y2TickFormat = f
+}
+
+// UpdateXTickFormat sets the format for charts with a numeric x-axis.
+func (b *BaseChart) UpdateXTickFormat(f func(input float64) string) {
+	b.xTickFormat = f
+}
+
 type baseChartRenderer struct {
 	baseChart *BaseChart
 
-	titleLbl   *canvas.Text
-	yLbl       *canvas.Text
-	ySeparator *canvas.Line
-	xLbl       *canvas.Text
-	xSeparator *canvas.Line
+	titleLbl    *canvas.Text
+	yLbl        *canvas.Text
+	ySeparator  *canvas.Line
+	y2Lbl       *canvas.Text
+	y2Separator *canvas.Line
+	xLbl        *canvas.Text
+	xSeparator  *canvas.Line
+
+	yLabels          []*widget.Label
+	yLabelPositions  map[*widget.Label]float64
+	y2Labels         []*widget.Label
+	y2LabelPositions map[*widget.Label]float64
+	xLabels          []*widget.Label
+	xLabelPositions  map[*widget.Label]float64
+
+	yLblMax  fyne.Size
+	y2LblMax fyne.Size
+	xLblMax  fyne.Size
 
-	yLabels         []*widget.Label
-	yLabelPositions map[*widget.Label]float64
-	xLabels         []*widget.Label
+	yAxis axis
+	// y2Axis is the right y-axis, set by charts that have series bound to it, see valueAxis.
+	y2Axis *axis
 
-	yLblMax fyne.Size
-	xLblMax fyne.Size
+	// xAxis is set by charts that place data along a continuous x-axis rather than in label columns,
+	// the x labels are then built from xTicks instead of the chart's xLabels.
+	xAxis  *axis
+	xTicks []tick
 
-	yAxis axis
+	// times is set by charts laid out along a time axis, see refreshTimeAxis.
+	times          []time.Time
+	timeLayout     string
+	timeTickFormat func(time.Time) string
+
+	// lowerPaneRatio is the share of the plot height given to a pane below the y-axis, such as a volume pane.
+	lowerPaneRatio float32
+
+	// insets is extra room a chart reserves around the plot, for legends and the like, set before Layout.
+	insets insets
+}
+
+type insets struct {
+	top, bottom, left, right float32
 }
 
 func (b *baseChartRenderer) Destroy() {
@@ -106,24 +211,30 @@ func (b *baseChartRenderer) Layout(size fyne.Size) {
 	b.titleLbl.Move(titlePos)
 	b.titleLbl.Resize(titleSize)
 
-	yPos := fyne.NewPos(theme.Padding(), titleSize.Height+2*theme.Padding())
-	b.yLbl.Move(yPos)
+	sidePos := fyne.NewPos(theme.Padding(), titleSize.Height+2*theme.Padding())
+	b.sideTitle().Move(sidePos)
 
-	xSize := b.xLabelSize()
-	xLblX := size.Width/2 - xSize.Width/2
-	xPos := fyne.NewPos(xLblX, size.Height-xSize.Height-theme.Padding())
-	b.xLbl.Move(xPos)
+	bottomEdge := size.Height - b.insets.bottom
+	bottomSize := b.bottomTitleSize()
+	bottomX := size.Width/2 - bottomSize.Width/2
+	bottomPos := fyne.NewPos(bottomX, bottomEdge-bottomSize.Height-theme.Padding())
+	b.bottomTitle().Move(bottomPos)
 
 	xOffset := b.xOffset()
 
 	reqBottom := b.requiredBottomHeight()
 	xSepY := size.Height - reqBottom
 	b.xSeparator.Position1 = fyne.NewPos(xOffset, xSepY)
-	b.xSeparator.Position2 = fyne.NewPos(size.Width-theme.Padding(), xSepY)
+	b.xSeparator.Position2 = fyne.NewPos(b.plotRight(size), xSepY)
 
 	b.ySeparator.Position1 = fyne.NewPos(xOffset, xSepY)
 	b.ySeparator.Position2 = fyne.NewPos(xOffset, b.requiredTopHeight())
 
+	if b.horizontal() {
+		b.layoutHorizontalLabels(size, xOffset)
+		return
+	}
+
 	availableHeight := b.availableHeight(size)
 	columnWidth := b.columnWidth(size, xOffset)
 
@@ -131,10 +242,25 @@ func (b *baseChartRenderer) Layout(size fyne.Size) {
 		for lbl, y := range b.yLabelPositions {
 			lblSize := lbl.MinSize()
 			scale := b.yAxis.normalize(y)
-			pos := fyne.NewPos(0, size.Height-reqBottom-(availableHeight*scale)-lblSize.Height/2)
+			pos := fyne.NewPos(b.insets.left, size.Height-reqBottom-b.lowerPaneHeight(size)-(availableHeight*scale)-lblSize.Height/2)
 			lbl.Move(pos)
 		}
 	}
+	if b.y2Axis != nil {
+		b.layoutRightAxis(size)
+	}
+
+	if b.xAxis != nil {
+		availableWidth := b.availableWidth(size, xOffset)
+		for lbl, x := range b.xLabelPositions {
+			lblSize := lbl.MinSize()
+			scale := b.xAxis.normalize(x)
+			lblPos := fyne.NewPos(xOffset+availableWidth*scale-lblSize.Width/2,
+				bottomEdge-2*theme.Padding()-bottomSize.Height-lblSize.Height)
+			lbl.Move(lblPos)
+		}
+		return
+	}
 
 	if len(b.baseChart.xLabels) > 0 {
 		for idx := range b.baseChart.xLabels {
@@ -142,32 +268,112 @@ func (b *baseChartRenderer) Layout(size fyne.Size) {
 			lblSize := lbl.MinSize()
 			xCellOffset := float32(idx) * columnWidth
 			lblPos := fyne.NewPos(xOffset+xCellOffset+columnWidth/2-lblSize.Width/2,
-				size.Height-2*theme.Padding()-xSize.Height-lblSize.Height)
+				bottomEdge-2*theme.Padding()-bottomSize.Height-lblSize.Height)
 			lbl.Move(lblPos)
 		}
 	}
 }
 
-func (b *baseChartRenderer) xLabelSize() fyne.Size {
-	xSize := fyne.NewSize(0, 0)
-	if b.xLbl.Visible() {
-		xSize = b.xLbl.MinSize()
+// layoutRightAxis places the right y-axis, its ticks and its title just right of the plot.
+func (b *baseChartRenderer) layoutRightAxis(size fyne.Size) {
+	plotRight := b.plotRight(size)
+	bottom := size.Height - b.requiredBottomHeight()
+	b.y2Separator.Position1 = fyne.NewPos(plotRight, bottom)
+	b.y2Separator.Position2 = fyne.NewPos(plotRight, b.requiredTopHeight())
+
+	availableHeight := b.availableHeight(size)
+	for lbl, y := range b.y2LabelPositions {
+		lblSize := lbl.MinSize()
+		scale := b.y2Axis.normalize(y)
+		lbl.Move(fyne.NewPos(plotRight, bottom-b.lowerPaneHeight(size)-(availableHeight*scale)-lblSize.Height/2))
+	}
+
+	titleSize := b.titleLbl.MinSize()
+	b.y2Lbl.Move(fyne.NewPos(size.Width-b.insets.right-theme.Padding()-b.y2Lbl.MinSize().Width, titleSize.Height+2*theme.Padding()))
+}
+
+// layoutHorizontalLabels places the category labels down the left side and the value ticks along the bottom.
+func (b *baseChartRenderer) layoutHorizontalLabels(size fyne.Size, xOffset float32) {
+	bottomEdge := size.Height - b.insets.bottom
+	bottomSize := b.bottomTitleSize()
+	availableWidth := b.availableWidth(size, xOffset)
+	rowHeight := b.rowHeight(size)
+	top := b.requiredTopHeight()
+
+	for lbl, y := range b.yLabelPositions {
+		lblSize := lbl.MinSize()
+		scale := b.yAxis.normalize(y)
+		pos := fyne.NewPos(xOffset+availableWidth*scale-lblSize.Width/2,
+			bottomEdge-2*theme.Padding()-bottomSize.Height-lblSize.Height)
+		lbl.Move(pos)
+	}
+
+	for idx := range b.baseChart.xLabels {
+		lbl := b.xLabels[idx]
+		lblSize := lbl.MinSize()
+		yCellOffset := float32(idx) * rowHeight
+		lblPos := fyne.NewPos(xOffset-theme.Padding()-lblSize.Width, top+yCellOffset+rowHeight/2-lblSize.Height/2)
+		lbl.Move(lblPos)
+	}
+}
+
+func (b *baseChartRenderer) horizontal() bool {
+	return b.baseChart.orientation == OrientationHorizontal
+}
+
+// bottomTitle is the axis title drawn under the chart, the value title when the chart is horizontal.
+func (b *baseChartRenderer) bottomTitle() *canvas.Text {
+	if b.horizontal() {
+		return b.yLbl
+	}
+	return b.xLbl
+}
+
+// sideTitle is the axis title drawn above the left axis, the category title when the chart is horizontal.
+func (b *baseChartRenderer) sideTitle() *canvas.Text {
+	if b.horizontal() {
+		return b.xLbl
+	}
+	return b.yLbl
+}
+
+// bottomLabelMax is the largest label drawn along the bottom axis.
+func (b *baseChartRenderer) bottomLabelMax() fyne.Size {
+	if b.horizontal() {
+		return b.yLblMax
 	}
-	return xSize
+	return b.xLblMax
 }
 
-func (b *baseChartRenderer) yLabelSize() fyne.Size {
-	ySize := fyne.NewSize(0, 0)
-	if b.yLbl.Visible() {
-		ySize = b.yLbl.MinSize()
+// sideLabelMax is the largest label drawn along the left axis.
+func (b *baseChartRenderer) sideLabelMax() fyne.Size {
+	if b.horizontal() {
+		return b.xLblMax
 	}
-	return ySize
+	return b.yLblMax
+}
+
+func (b *baseChartRenderer) bottomTitleSize() fyne.Size {
+	return visibleTextSize(b.bottomTitle())
+}
+
+// sideTitleSize is the size of the titles drawn above the y-axes.
+func (b *baseChartRenderer) sideTitleSize() fyne.Size {
+	size := visibleTextSize(b.sideTitle())
+	if b.y2Axis != nil {
+		size = size.Max(visibleTextSize(b.y2Lbl))
+	}
+	return size
 }
 
 func (b *baseChartRenderer) titleLabelSize() fyne.Size {
+	return visibleTextSize(b.titleLbl)
+}
+
+func visibleTextSize(t *canvas.Text) fyne.Size {
 	tSize := fyne.NewSize(0, 0)
-	if b.titleLbl.Visible() {
-		tSize = b.titleLbl.MinSize()
+	if t.Visible() {
+		tSize = t.MinSize()
 	}
 	return tSize
 }
@@ -175,7 +381,10 @@ func (b *baseChartRenderer) titleLabelSize() fyne.Size {
 func (b *baseChartRenderer) xOffset() float32 {
 	//ySize := b.yLabelSize()
 	//return fyne.Max(b.yLblMax.Width, ySize.Width+2*theme.Padding())
-	return b.yLblMax.Width + theme.Padding()
+	if b.horizontal() {
+		return b.insets.left + b.xLblMax.Width + 2*theme.Padding()
+	}
+	return b.insets.left + b.yLblMax.Width + theme.Padding()
 }
 
 func (b *baseChartRenderer) MinSize() fyne.Size {
@@ -183,7 +392,36 @@ func (b *baseChartRenderer) MinSize() fyne.Size {
 }
 
 func (b *baseChartRenderer) columnWidth(size fyne.Size, xOffset float32) float32 {
-	return (size.Width - xOffset - theme.Padding()) / float32(len(b.baseChart.xLabels))
+	return b.availableWidth(size, xOffset) / float32(len(b.baseChart.xLabels))
+}
+
+// rowHeight is the height given to each category when the chart is horizontal.
+func (b *baseChartRenderer) rowHeight(size fyne.Size) float32 {
+	return b.availableHeight(size) / float32(len(b.baseChart.xLabels))
+}
+
+// rightAxisWidth is the room taken by the right y-axis tick labels, if the chart has one.
+func (b *baseChartRenderer) rightAxisWidth() float32 {
+	if b.y2Axis == nil {
+		return 0
+	}
+	return b.y2LblMax.Width + theme.Padding()
+}
+
+// plotRight is the x of the right edge of the plot, which the right y-axis is drawn along.
+func (b *baseChartRenderer) plotRight(size fyne.Size) float32 {
+	return size.Width - theme.Padding() - b.insets.right - b.rightAxisWidth()
+}
+
+func (b *baseChartRenderer) availableWidth(size fyne.Size, xOffset float32) float32 {
+	width := b.plotRight(size) - xOffset
+	// Leave room for the last tick along the bottom, which is centered on the end of the axis.
+	if b.horizontal() {
+		width -= b.yLblMax.Width / 2
+	} else if b.xAxis != nil {
+		width -= b.xLblMax.Width / 2
+	}
+	return width
 }
 
 func (b *baseChartRenderer) requiredTopHeight() float32 {
@@ -192,34 +430,56 @@ func (b *baseChartRenderer) requiredTopHeight() float32 {
 	if titleSize.Height > 0 {
 		paddingCount += 3
 	}
-	ySize := b.yLabelSize()
-	if ySize.Height > 0 {
+	sideSize := b.sideTitleSize()
+	if sideSize.Height > 0 {
 		paddingCount += 2
 	}
 
-	return titleSize.Height + ySize.Height + float32(paddingCount)*theme.Padding()
+	return titleSize.Height + sideSize.Height + float32(paddingCount)*theme.Padding() + b.insets.top
 }
 
 func (b *baseChartRenderer) requiredBottomHeight() float32 {
 	paddingCount := 0
-	xSize := b.xLabelSize()
-	if xSize.Height > 0 {
+	bottomSize := b.bottomTitleSize()
+	if bottomSize.Height > 0 {
 		paddingCount += 2
 	}
 
-	if b.xLblMax.Height > 0 {
+	lblMax := b.bottomLabelMax()
+	if lblMax.Height > 0 {
 		paddingCount++
 	}
 
-	return xSize.Height + b.xLblMax.Height + float32(paddingCount)*theme.Padding()
+	return bottomSize.Height + lblMax.Height + float32(paddingCount)*theme.Padding() + b.insets.bottom
 }
 
 func (b *baseChartRenderer) availableHeight(size fyne.Size) float32 {
+	return b.plotHeight(size) - b.lowerPaneHeight(size)
+}
+
+// plotHeight is the height between the top and bottom labels, including any lower pane.
+func (b *baseChartRenderer) plotHeight(size fyne.Size) float32 {
 	topHeight := b.requiredTopHeight()
 	bottomHeight := b.requiredBottomHeight()
 	return size.Height - topHeight - bottomHeight
 }
 
+func (b *baseChartRenderer) lowerPaneHeight(size fyne.Size) float32 {
+	if b.lowerPaneRatio <= 0 {
+		return 0
+	}
+	return (size.Height - b.requiredTopHeight() - b.requiredBottomHeight()) * b.lowerPaneRatio
+}
+
+// xCenter is the horizontal center of the idx'th datum, in its label column or at its place on the time axis.
+func (b *baseChartRenderer) xCenter(idx int, size fyne.Size, xOffset float32) float32 {
+	if b.xAxis != nil && idx < len(b.times) {
+		return xOffset + b.availableWidth(size, xOffset)*b.xAxis.normalize(timeValue(b.times[idx]))
+	}
+	columnWidth := b.columnWidth(size, xOffset)
+	return xOffset + float32(idx)*columnWidth + columnWidth/2
+}
+
 func (b *baseChartRenderer) Objects() []fyne.CanvasObject {
 	cos := []fyne.CanvasObject{b.titleLbl, b.yLbl, b.xLbl}
 	for _, lbl := range b.yLabels {
@@ -238,6 +498,13 @@ func (b *baseChartRenderer) Objects() []fyne.CanvasObject {
 		cos = append(cos, b.ySeparator)
 	}
 
+	if b.y2Axis != nil {
+		cos = append(cos, b.y2Lbl, b.y2Separator)
+		for _, lbl := range b.y2Labels {
+			cos = append(cos, lbl)
+		}
+	}
+
 	return cos
 }
 
@@ -258,6 +525,14 @@ func (b *baseChartRenderer) Refresh() {
 		b.yLbl.Hide()
 	}
 
+	if b.baseChart.y2Title != "" {
+		b.y2Lbl.Text = b.baseChart.y2Title
+		b.y2Lbl.Refresh()
+		b.y2Lbl.Show()
+	} else {
+		b.y2Lbl.Hide()
+	}
+
 	if b.baseChart.xTitle != "" {
 		b.xLbl.Text = b.baseChart.xTitle
 		b.xLbl.Refresh()
@@ -270,17 +545,22 @@ func (b *baseChartRenderer) Refresh() {
 	//	lbl.Hide()
 	//}
 	b.xLabels = nil
-	for idx := range b.baseChart.xLabels {
-		var lbl *widget.Label
-		if idx >= len(b.xLabels) {
-			lbl = widget.NewLabel(b.baseChart.xLabels[idx])
-			b.xLabels = append(b.xLabels, lbl)
-		} else {
-			lbl = b.xLabels[idx]
-			lbl.SetText(b.baseChart.xLabels[idx])
+	clear(b.xLabelPositions)
+	if b.xAxis != nil {
+		b.refreshXTicks()
+	} else {
+		for idx := range b.baseChart.xLabels {
+			var lbl *widget.Label
+			if idx >= len(b.xLabels) {
+				lbl = widget.NewLabel(b.baseChart.xLabels[idx])
+				b.xLabels = append(b.xLabels, lbl)
+			} else {
+				lbl = b.xLabels[idx]
+				lbl.SetText(b.baseChart.xLabels[idx])
+			}
+			lbl.Show()
+			b.xLblMax = b.xLblMax.Max(lbl.MinSize())
 		}
-		lbl.Show()
-		b.xLblMax = b.xLblMax.Max(lbl.MinSize())
 	}
 
 	clear(b.yLabelPositions)
@@ -288,11 +568,14 @@ func (b *baseChartRenderer) Refresh() {
 	//	lbl.Hide()
 	//}
 	b.yLabels = nil
-	tickLabels, _, _, _, err := generateTicks(b.yAxis.min, b.yAxis.max, b.baseChart.suggestedTickCount, containmentContainData, defaultQ(), defaultWeights(), defaultLegibility)
+	b.yAxis.fit(b.baseChart.yRange, b.baseChart.suggestedTickCount)
+	b.baseChart.yScale.apply(&b.yAxis)
+	tickLabels, err := b.baseChart.yScale.ticks(b.yAxis, b.baseChart.suggestedTickCount, b.baseChart.tickGenerator)
 	if err != nil {
 		log.Println("error generating ticks")
 		return
 	}
+	tickLabels = b.yAxis.fitTicks(tickLabels)
 
 	for idx, tl := range tickLabels {
 		var lbl *widget.Label
@@ -308,4 +591,135 @@ func (b *baseChartRenderer) Refresh() {
 		b.yLabelPositions[lbl] = tl
 	}
 
+	b.refreshRightAxis()
+}
+
+// refreshRightAxis builds the right y-axis tick labels, when the chart has series bound to it.
+func (b *baseChartRenderer) refreshRightAxis() {
+	clear(b.y2LabelPositions)
+	b.y2Labels = nil
+	b.y2LblMax = fyne.NewSize(0, 0)
+	if b.y2Axis == nil {
+		return
+	}
+
+	b.y2Axis.fit(b.baseChart.y2Range, b.baseChart.suggestedTickCount)
+	b.baseChart.y2Scale.apply(b.y2Axis)
+	tickLabels, err := b.baseChart.y2Scale.ticks(*b.y2Axis, b.baseChart.suggestedTickCount, b.baseChart.tickGenerator)
+	if err != nil {
+		log.Println("error generating right axis ticks")
+		return
+	}
+	tickLabels = b.y2Axis.fitTicks(tickLabels)
+	for _, tl := range tickLabels {
+		lbl := widget.NewLabel(b.baseChart.y2TickFormat(tl))
+		b.y2Labels = append(b.y2Labels, lbl)
+		b.y2LblMax = b.y2LblMax.Max(lbl.MinSize())
+		b.y2LabelPositions[lbl] = tl
+	}
+}
+
+// valueAxis is the axis a series on side is measured against, creating the right axis the first time it is asked for,
+// so charts can extend the range of either while walking their data.
+func (b *baseChartRenderer) valueAxis(side AxisSide) *axis {
+	if side != AxisRight {
+		return &b.yAxis
+	}
+	if b.y2Axis == nil {
+		b.y2Axis = &axis{normalizer: linearNormalizer{}}
+	}
+	return b.y2Axis
+}
+
+// updateDataRanges records the range of the y-axes once the data has been walked.
+func (b *baseChartRenderer) updateDataRanges() {
+	b.yAxis.dataRange = b.yAxis.max - b.yAxis.min
+	if b.y2Axis != nil {
+		b.y2Axis.dataRange = b.y2Axis.max - b.y2Axis.min
+	}
+}
+
+// refreshNumericXAxis sets up a continuous x-axis covering values, with ticks from the x tick generator.
+func (b *baseChartRenderer) refreshNumericXAxis(values []float64) {
+	b.xTicks = nil
+	b.xAxis = &axis{normalizer: linearNormalizer{}}
+	if len(values) == 0 {
+		return
+	}
+
+	b.xAxis.min, b.xAxis.max = values[0], values[0]
+	for _, v := range values {
+		b.xAxis.include(v)
+	}
+	if b.xAxis.min == b.xAxis.max {
+		b.xAxis.min--
+		b.xAxis.max++
+	}
+	b.xAxis.dataRange = b.xAxis.max - b.xAxis.min
+
+	tickValues, err := orDefaultTicks(b.baseChart.xTickGenerator).Ticks(b.xAxis.min, b.xAxis.max, b.baseChart.suggestedTickCount)
+	if err != nil {
+		log.Println("error generating x ticks")
+		return
+	}
+	tickValues = b.xAxis.fitTicks(tickValues)
+	for _, tv := range tickValues {
+		b.xTicks = append(b.xTicks, tick{value: tv, label: b.baseChart.xTickFormat(tv)})
+	}
+}
+
+func (b *baseChartRenderer) refreshXTicks() {
+	for _, tk := range b.xTicks {
+		lbl := widget.NewLabel(tk.label)
+		b.xLabels = append(b.xLabels, lbl)
+		b.xLblMax = b.xLblMax.Max(lbl.MinSize())
+		b.xLabelPositions[lbl] = tk.value
+	}
+}
+
+// refreshTimeAxis sets up the continuous x-axis and its calendar ticks when the chart has times,
+// format overrides the automatic tick format when set.
+func (b *baseChartRenderer) refreshTimeAxis(times []time.Time, format func(time.Time) string) {
+	b.xAxis = nil
+	b.xTicks = nil
+	b.times = times
+	b.timeLayout = ""
+	b.timeTickFormat = format
+	if times == nil {
+		return
+	}
+
+	xAxis := &axis{normalizer: linearNormalizer{}}
+	if len(times) > 0 {
+		minTime, maxTime := times[0], times[0]
+		for _, tm := range times {
+			if tm.Before(minTime) {
+				minTime = tm
+			}
+			if tm.After(maxTime) {
+				maxTime = tm
+			}
+		}
+		xAxis.min, xAxis.max = timeValue(minTime), timeValue(maxTime)
+		if xAxis.max == xAxis.min {
+			// Give a single instant some width so it lands in the middle of the axis.
+			xAxis.min--
+			xAxis.max++
+		}
+		xAxis.dataRange = xAxis.max - xAxis.min
+
+		ticks, layout := generateTimeTicks(minTime, maxTime, b.baseChart.suggestedTickCount)
+		b.timeLayout = layout
+		for _, tk := range ticks {
+			b.xTicks = append(b.xTicks, tick{value: timeValue(tk), label: b.formatTime(tk)})
+		}
+	}
+	b.xAxis = xAxis
+}
+
+func (b *baseChartRenderer) formatTime(tm time.Time) string {
+	if b.timeTickFormat != nil {
+		return b.timeTickFormat(tm)
+	}
+	return tm.Format(b.timeLayout)
 }
@@ -0,0 +1,263 @@
+package fynecharts
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"math"
+)
+
+const (
+	defaultBoxWidth        = 30
+	defaultOutlierDiameter = 6
+)
+
+type WhiskerRule int
+
+const (
+	// WhiskerTukey extends the whiskers to the furthest samples within 1.5 IQR of the box, the rest are outliers.
+	WhiskerTukey WhiskerRule = iota
+	// WhiskerMinMax extends the whiskers to the smallest and largest samples.
+	WhiskerMinMax
+)
+
+// boxSummary is the five-number summary of a category, the whisker ends standing in for min and max.
+// A category without samples has a zero count and nothing drawn.
+type boxSummary struct {
+	count                     int
+	low, q1, median, q3, high float64
+	outliers                  []float64
+}
+
+func summarize(samples []float64, rule WhiskerRule) boxSummary {
+	sorted := sortedCopy(samples)
+	if len(sorted) == 0 {
+		return boxSummary{}
+	}
+
+	s := boxSummary{
+		count:  len(sorted),
+		low:    sorted[0],
+		q1:     quantile(sorted, 0.25),
+		median: quantile(sorted, 0.5),
+		q3:     quantile(sorted, 0.75),
+		high:   sorted[len(sorted)-1],
+	}
+	if rule == WhiskerTukey {
+		iqr := s.q3 - s.q1
+		lowFence, highFence := s.q1-1.5*iqr, s.q3+1.5*iqr
+		s.low, s.high = s.q1, s.q3
+		for _, v := range sorted {
+			if v < lowFence || v > highFence {
+				s.outliers = append(s.outliers, v)
+				continue
+			}
+			s.low = math.Min(s.low, v)
+			s.high = math.Max(s.high, v)
+		}
+	}
+	return s
+}
+
+type BoxPlot struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	samples [][]float64
+
+	whiskerRule WhiskerRule
+	boxWidth    float32
+
+	color       color.Color
+	hoverFormat func(float64) string
+}
+
+func (b *BoxPlot) CreateRenderer() fyne.WidgetRenderer {
+	bcr := b.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &boxPlotRenderer{baseChartRenderer: bcr, boxPlot: b}
+}
+
+// NewBoxPlot summarizes the raw samples of each category, samples[idx] belonging to labels[idx].
+func NewBoxPlot(canvas fyne.Canvas, title string, labels []string, samples [][]float64) *BoxPlot {
+	bp := &BoxPlot{BaseChart: newBaseChart(title, labels, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		samples:     samples,
+		boxWidth:    defaultBoxWidth,
+		hoverFormat: defaultHoverFormat,
+	}
+	bp.ExtendBaseWidget(bp)
+	bp.Refresh()
+
+	return bp
+}
+
+func (b *BoxPlot) UpdateData(labels []string, samples [][]float64) {
+	b.xLabels = labels
+	b.samples = samples
+	b.Refresh()
+}
+
+func (b *BoxPlot) SetWhiskerRule(rule WhiskerRule) {
+	b.whiskerRule = rule
+	b.Refresh()
+}
+
+func (b *BoxPlot) SetBoxWidth(w float32) {
+	b.boxWidth = w
+	b.Refresh()
+}
+
+// UpdateBoxColor sets the box color, nil uses the theme's primary color.
+func (b *BoxPlot) UpdateBoxColor(c color.Color) {
+	b.color = c
+	b.Refresh()
+}
+
+func (b *BoxPlot) UpdateHoverFormat(f func(float642 float64) string) {
+	b.hoverFormat = f
+	b.Refresh()
+}
+
+// summaryText lists the summary from the top down, Tukey whiskers end at the furthest samples that are not outliers
+// so they are not labelled as the min and max.
+func (b *BoxPlot) summaryText(s boxSummary) string {
+	high, low := "Max", "Min"
+	if b.whiskerRule == WhiskerTukey {
+		high, low = "Upper whisker", "Lower whisker"
+	}
+	return fmt.Sprintf("%s: %s\nQ3: %s\nMedian: %s\nQ1: %s\n%s: %s",
+		high, b.hoverFormat(s.high), b.hoverFormat(s.q3), b.hoverFormat(s.median), b.hoverFormat(s.q1), low, b.hoverFormat(s.low))
+}
+
+// boxShape holds the objects drawing a single category.
+type boxShape struct {
+	box                     *bar
+	median                  *canvas.Line
+	lowWhisker, highWhisker *canvas.Line
+	lowCap, highCap         *canvas.Line
+	outliers                []*dot
+}
+
+type boxPlotRenderer struct {
+	*baseChartRenderer
+	boxPlot *BoxPlot
+
+	summaries []boxSummary
+	shapes    []boxShape
+}
+
+func (b *boxPlotRenderer) Destroy() {
+
+}
+
+func (b *boxPlotRenderer) Layout(size fyne.Size) {
+	b.baseChartRenderer.Layout(size)
+
+	xOffset := b.xOffset()
+
+	availableHeight := b.availableHeight(size)
+	columnWidth := b.columnWidth(size, xOffset)
+	boxWidth := fyne.Min(b.boxPlot.boxWidth, columnWidth-theme.Padding())
+
+	reqBottom := b.requiredBottomHeight()
+	yFor := func(v float64) float32 {
+		return size.Height - reqBottom - availableHeight*b.yAxis.normalize(v)
+	}
+	for idx, shape := range b.shapes {
+		s := b.summaries[idx]
+		if s.count == 0 {
+			continue
+		}
+		center := xOffset + float32(idx)*columnWidth + columnWidth/2
+		left, right := center-boxWidth/2, center+boxWidth/2
+
+		shape.box.Move(fyne.NewPos(left, yFor(s.q3)))
+		shape.box.Resize(fyne.NewSize(boxWidth, yFor(s.q1)-yFor(s.q3)))
+		shape.median.Position1, shape.median.Position2 = fyne.NewPos(left, yFor(s.median)), fyne.NewPos(right, yFor(s.median))
+
+		shape.highWhisker.Position1, shape.highWhisker.Position2 = fyne.NewPos(center, yFor(s.q3)), fyne.NewPos(center, yFor(s.high))
+		shape.lowWhisker.Position1, shape.lowWhisker.Position2 = fyne.NewPos(center, yFor(s.q1)), fyne.NewPos(center, yFor(s.low))
+		shape.highCap.Position1, shape.highCap.Position2 = fyne.NewPos(center-boxWidth/4, yFor(s.high)), fyne.NewPos(center+boxWidth/4, yFor(s.high))
+		shape.lowCap.Position1, shape.lowCap.Position2 = fyne.NewPos(center-boxWidth/4, yFor(s.low)), fyne.NewPos(center+boxWidth/4, yFor(s.low))
+
+		for outlierIdx, dt := range shape.outliers {
+			dt.Resize(fyne.NewSize(defaultOutlierDiameter, defaultOutlierDiameter))
+			dt.Move(fyne.NewPos(center-defaultOutlierDiameter/2, yFor(s.outliers[outlierIdx])-defaultOutlierDiameter/2))
+		}
+	}
+}
+
+func (b *boxPlotRenderer) MinSize() fyne.Size {
+	xCellWidth := fyne.Max(b.xLblMax.Width, b.boxPlot.boxWidth) + 2
+	return fyne.NewSize(b.xOffset()+float32(len(b.boxPlot.xLabels))*xCellWidth,
+		b.requiredTopHeight()+b.requiredBottomHeight()+b.boxPlot.minHeight)
+}
+
+func (b *boxPlotRenderer) Objects() []fyne.CanvasObject {
+	cos := b.baseChartRenderer.Objects()
+	for _, shape := range b.shapes {
+		if shape.box == nil {
+			continue
+		}
+		cos = append(cos, shape.lowWhisker, shape.highWhisker, shape.lowCap, shape.highCap)
+		for _, dt := range shape.outliers {
+			cos = append(cos, dt)
+		}
+	}
+	// Boxes go last so their hover text is drawn over neighbouring whiskers.
+	for _, shape := range b.shapes {
+		if shape.box == nil {
+			continue
+		}
+		cos = append(cos, shape.box, shape.median)
+	}
+	return cos
+}
+
+func (b *boxPlotRenderer) Refresh() {
+	b.yAxis = axis{normalizer: linearNormalizer{}}
+	b.summaries = nil
+	b.shapes = nil
+
+	c := b.boxPlot.color
+	if c == nil {
+		c = theme.PrimaryColor()
+	}
+	for _, samples := range b.boxPlot.samples {
+		s := summarize(samples, b.boxPlot.whiskerRule)
+		b.summaries = append(b.summaries, s)
+		// An empty category keeps its slot but adds nothing to the axis or the plot.
+		if s.count == 0 {
+			b.shapes = append(b.shapes, boxShape{})
+			continue
+		}
+		b.yAxis.include(s.low)
+		b.yAxis.include(s.high)
+
+		shape := boxShape{
+			box:         newBar(b.boxPlot.canvas, b.boxPlot.summaryText(s), c),
+			median:      newBoxLine(theme.BackgroundColor()),
+			lowWhisker:  newBoxLine(theme.ForegroundColor()),
+			highWhisker: newBoxLine(theme.ForegroundColor()),
+			lowCap:      newBoxLine(theme.ForegroundColor()),
+			highCap:     newBoxLine(theme.ForegroundColor()),
+		}
+		for _, o := range s.outliers {
+			b.yAxis.include(o)
+			shape.outliers = append(shape.outliers, newDot(b.boxPlot.canvas, b.boxPlot.hoverFormat(o), MarkerCircle, theme.ForegroundColor()))
+		}
+		b.shapes = append(b.shapes, shape)
+	}
+	b.yAxis.dataRange = b.yAxis.max - b.yAxis.min
+
+	b.baseChartRenderer.Refresh()
+}
+
+func newBoxLine(c color.Color) *canvas.Line {
+	l := canvas.NewLine(c)
+	l.StrokeWidth = 2
+	return l
+}
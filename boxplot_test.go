@@ -0,0 +1,52 @@
+package fynecharts
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSummarizeTukey(t *testing.T) {
+	s := summarize([]float64{7, 1, 2, 3, 4, 5, 6, 30}, WhiskerTukey)
+	if s.q1 != 2.75 || s.median != 4.5 || s.q3 != 6.25 {
+		t.Error("unexpected quartiles", s.q1, s.median, s.q3)
+	}
+	if s.low != 1 || s.high != 7 {
+		t.Error("unexpected whiskers", s.low, s.high)
+	}
+	if len(s.outliers) != 1 || s.outliers[0] != 30 {
+		t.Error("expected 30 to be an outlier", s.outliers)
+	}
+}
+
+func TestSummarizeMinMax(t *testing.T) {
+	s := summarize([]float64{7, 1, 2, 3, 4, 5, 6, 30}, WhiskerMinMax)
+	if s.low != 1 || s.high != 30 || len(s.outliers) != 0 {
+		t.Error("expected whiskers to reach every sample", s.low, s.high, s.outliers)
+	}
+}
+
+func TestBoxPlotSkipsEmptyCategories(t *testing.T) {
+	bp := NewBoxPlot(nil, "", []string{"a", "b", "c"}, [][]float64{{10, 12, 14}, nil, {11, 13, 15}})
+	bp.SetYRange(AxisRange{ExcludeZero: true})
+	br := bp.CreateRenderer().(*boxPlotRenderer)
+	br.Refresh()
+
+	if br.yAxis.min != 10 || br.yAxis.max != 15 {
+		t.Error("expected the empty category to leave the axis alone", br.yAxis.min, br.yAxis.max)
+	}
+	if len(br.shapes) != 3 || br.shapes[1].box != nil || br.shapes[2].box == nil {
+		t.Error("expected the empty category to keep its slot without a box")
+	}
+}
+
+func TestBoxPlotSummaryTextNamesWhiskers(t *testing.T) {
+	bp := NewBoxPlot(nil, "", []string{"a"}, [][]float64{{1, 2, 3}})
+	s := summarize([]float64{1, 2, 3}, WhiskerTukey)
+	if text := bp.summaryText(s); !strings.HasPrefix(text, "Upper whisker: ") || !strings.Contains(text, "Lower whisker: ") {
+		t.Error("expected Tukey whiskers to be named as such", text)
+	}
+	bp.SetWhiskerRule(WhiskerMinMax)
+	if text := bp.summaryText(s); !strings.HasPrefix(text, "Max: ") || !strings.Contains(text, "Min: ") {
+		t.Error("expected min/max whiskers to be named min and max", text)
+	}
+}
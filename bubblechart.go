@@ -0,0 +1,44 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// BubbleChart is a ScatterChart whose marker areas are proportional to a third value.
+type BubbleChart struct {
+	*ScatterChart
+}
+
+func NewBubbleChart(canvas fyne.Canvas, title string, x, y, sizes []float64) *BubbleChart {
+	sc := newScatterChart(canvas, title, x, y)
+	sc.sizes = sizes
+	bc := &BubbleChart{ScatterChart: sc}
+	bc.ExtendBaseWidget(bc)
+	bc.Refresh()
+
+	return bc
+}
+
+func (b *BubbleChart) UpdateData(x, y, sizes []float64) {
+	b.xData = x
+	b.yData = y
+	b.sizes = sizes
+	b.Refresh()
+}
+
+// SetDiameterRange bounds the bubble diameters, the largest size is drawn at max and no bubble is smaller than min.
+func (b *BubbleChart) SetDiameterRange(min, max float32) {
+	b.minDiameter = min
+	b.maxDiameter = max
+	b.Refresh()
+}
+
+func (b *BubbleChart) SetShowSizeLegend(show bool) {
+	b.showSizeLegend = show
+	b.Refresh()
+}
+
+func (b *BubbleChart) UpdateSizeFormat(f func(float64) string) {
+	b.sizeFormat = f
+	b.Refresh()
+}
@@ -0,0 +1,391 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"math"
+	"time"
+)
+
+const (
+	defaultCalendarSteps    = 4
+	defaultCalendarCellSize = 10
+	calendarCellGap         = 2
+	calendarSwatchSize      = 12
+	calendarDateFormat      = "Mon Jan 2, 2006"
+)
+
+// DatedValue is a value recorded on a day.
+type DatedValue struct {
+	Date  time.Time
+	Value float64
+}
+
+// CalendarHeatmap colors a value per day, with weeks as columns starting on Monday and the weekdays as rows.
+// Values on the same day are summed, days without a value are left empty. A day is the calendar date of a value
+// in its own location, so the same date in different zones is the same day, and days passed back are midnight UTC.
+type CalendarHeatmap struct {
+	widget.BaseWidget
+	canvas fyne.Canvas
+
+	title string
+	// values are keyed by their day, see dayOf.
+	values     map[time.Time]float64
+	start, end time.Time
+	// fixedRange is set when the range was chosen with SetDateRange rather than taken from the values.
+	fixedRange bool
+
+	scale       ColorScale
+	hoverFormat func(float64) string
+	onTouched   func(day time.Time)
+
+	tooltip *tooltip
+	// plotPos and cellSize are set during layout to find the day under the pointer.
+	plotPos  fyne.Position
+	cellSize float32
+}
+
+func NewCalendarHeatmap(canvas fyne.Canvas, title string, values map[time.Time]float64) *CalendarHeatmap {
+	ch := &CalendarHeatmap{canvas: canvas, title: title,
+		scale:       ColorScale{Palette: PaletteGreens, Steps: defaultCalendarSteps},
+		hoverFormat: defaultHoverFormat,
+		tooltip:     newTooltip(),
+	}
+	ch.ExtendBaseWidget(ch)
+	ch.UpdateData(values)
+
+	return ch
+}
+
+func (c *CalendarHeatmap) CreateRenderer() fyne.WidgetRenderer {
+	titleLbl := canvas.NewText(c.title, theme.ForegroundColor())
+	titleLbl.TextSize = theme.TextSize() + 6
+	titleLbl.Hide()
+
+	return &calendarHeatmapRenderer{calendarHeatmap: c, titleLbl: titleLbl}
+}
+
+func (c *CalendarHeatmap) UpdateData(values map[time.Time]float64) {
+	c.values = make(map[time.Time]float64)
+	for day, v := range values {
+		c.values[dayOf(day)] += v
+	}
+	c.refreshRange()
+}
+
+func (c *CalendarHeatmap) UpdateDatedValues(values []DatedValue) {
+	c.values = make(map[time.Time]float64)
+	for _, dv := range values {
+		c.values[dayOf(dv.Date)] += dv.Value
+	}
+	c.refreshRange()
+}
+
+// SetDateRange fixes the days shown, by default the calendar runs from the first to the last day with a value.
+func (c *CalendarHeatmap) SetDateRange(start, end time.Time) {
+	c.start, c.end = dayOf(start), dayOf(end)
+	c.fixedRange = true
+	c.tooltip.Hide()
+	c.Refresh()
+}
+
+// SetColorScale replaces the default scale of four greens.
+func (c *CalendarHeatmap) SetColorScale(scale ColorScale) {
+	c.scale = scale
+	c.Refresh()
+}
+
+func (c *CalendarHeatmap) UpdateHoverFormat(f func(float642 float64) string) {
+	c.hoverFormat = f
+	c.Refresh()
+}
+
+func (c *CalendarHeatmap) UpdateOnTouched(f func(day time.Time)) {
+	c.onTouched = f
+}
+
+func (c *CalendarHeatmap) refreshRange() {
+	if !c.fixedRange {
+		first := true
+		for day := range c.values {
+			if first || day.Before(c.start) {
+				c.start = day
+			}
+			if first || day.After(c.end) {
+				c.end = day
+			}
+			first = false
+		}
+	}
+	c.tooltip.Hide()
+	c.Refresh()
+}
+
+func (c *CalendarHeatmap) Tapped(event *fyne.PointEvent) {
+	if day, ok := c.dayAt(event.Position); ok && c.onTouched != nil {
+		c.onTouched(day)
+	}
+}
+
+func (c *CalendarHeatmap) MouseIn(event *desktop.MouseEvent) {
+	c.MouseMoved(event)
+}
+
+func (c *CalendarHeatmap) MouseMoved(event *desktop.MouseEvent) {
+	day, ok := c.dayAt(event.Position)
+	if !ok {
+		c.MouseOut()
+		return
+	}
+	text := day.Format(calendarDateFormat) + ": "
+	if v, ok := c.values[day]; ok {
+		text += c.hoverFormat(v)
+	} else {
+		text += "no data"
+	}
+	c.tooltip.showAt(text, event.Position)
+	c.canvas.Refresh(c)
+}
+
+func (c *CalendarHeatmap) MouseOut() {
+	if c.tooltip.Visible() {
+		c.tooltip.Hide()
+		c.canvas.Refresh(c)
+	}
+}
+
+func (c *CalendarHeatmap) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+// firstWeekday is the Monday starting the first column.
+func (c *CalendarHeatmap) firstWeekday() time.Time {
+	return timeInterval{unit: timeUnitDay, count: 7}.floor(c.start)
+}
+
+func (c *CalendarHeatmap) weeks() int {
+	if len(c.values) == 0 && !c.fixedRange {
+		return 0
+	}
+	return daysBetween(c.firstWeekday(), c.end)/7 + 1
+}
+
+// dayAt is the day in range under pos.
+func (c *CalendarHeatmap) dayAt(pos fyne.Position) (time.Time, bool) {
+	if c.cellSize <= 0 {
+		return time.Time{}, false
+	}
+	rel := pos.Subtract(c.plotPos)
+	if rel.X < 0 || rel.Y < 0 {
+		return time.Time{}, false
+	}
+	col, row := int(rel.X/c.cellSize), int(rel.Y/c.cellSize)
+	if col >= c.weeks() || row >= 7 {
+		return time.Time{}, false
+	}
+	day := c.firstWeekday().AddDate(0, 0, col*7+row)
+	if day.Before(c.start) || day.After(c.end) {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+// dayOf is midnight UTC on t's calendar date in its own location, so days from different locations compare equal.
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween counts calendar days from a to b, ignoring daylight saving changes.
+func daysBetween(a, b time.Time) int {
+	utcA := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	utcB := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(utcB.Sub(utcA).Hours() / 24))
+}
+
+type calendarHeatmapRenderer struct {
+	calendarHeatmap *CalendarHeatmap
+
+	titleLbl    *canvas.Text
+	dayLabels   []*canvas.Text
+	monthLabels []*canvas.Text
+	monthCols   []int
+
+	// cells holds a rectangle for every day in range, cellDays being each one's offset from the first Monday.
+	cells    []*canvas.Rectangle
+	cellDays []int
+
+	// The legend shows a swatch per step between the lowest and highest values.
+	swatches            []*canvas.Rectangle
+	legendLow, legendHi *canvas.Text
+}
+
+func (c *calendarHeatmapRenderer) Destroy() {
+
+}
+
+func (c *calendarHeatmapRenderer) Layout(size fyne.Size) {
+	chart := c.calendarHeatmap
+	titleSize := visibleTextSize(c.titleLbl)
+	c.titleLbl.Move(fyne.NewPos(size.Width/2-titleSize.Width/2, theme.Padding()))
+	c.titleLbl.Resize(titleSize)
+
+	monthHeight := c.monthLabelHeight()
+	dayWidth := c.dayLabelWidth()
+	origin := fyne.NewPos(2*theme.Padding()+dayWidth, titleSize.Height+2*theme.Padding()+monthHeight)
+	available := fyne.NewSize(size.Width-origin.X-theme.Padding(), size.Height-origin.Y-c.legendHeight()-2*theme.Padding())
+	cell := float32(0)
+	if weeks := chart.weeks(); weeks > 0 {
+		cell = fyne.Max(fyne.Min(available.Width/float32(weeks), available.Height/7), 0)
+	}
+	chart.plotPos = origin
+	chart.cellSize = cell
+
+	for idx, rect := range c.cells {
+		col, row := c.cellDays[idx]/7, c.cellDays[idx]%7
+		rect.Move(fyne.NewPos(origin.X+float32(col)*cell, origin.Y+float32(row)*cell))
+		rect.Resize(fyne.NewSize(fyne.Max(cell-calendarCellGap, 0), fyne.Max(cell-calendarCellGap, 0)))
+	}
+
+	for idx, lbl := range c.dayLabels {
+		// Labels are every other weekday, starting with Monday.
+		row := float32(idx * 2)
+		lblSize := lbl.MinSize()
+		lbl.Move(fyne.NewPos(origin.X-theme.Padding()-lblSize.Width, origin.Y+row*cell+(cell-calendarCellGap)/2-lblSize.Height/2))
+	}
+	for idx, lbl := range c.monthLabels {
+		lbl.Move(fyne.NewPos(origin.X+float32(c.monthCols[idx])*cell, origin.Y-monthHeight))
+	}
+
+	right := origin.X + float32(chart.weeks())*cell - calendarCellGap
+	legendY := origin.Y + 7*cell + theme.Padding()
+	hiSize := c.legendHi.MinSize()
+	x := right - hiSize.Width
+	c.legendHi.Move(fyne.NewPos(x, legendY+calendarSwatchSize/2-hiSize.Height/2))
+	for idx := len(c.swatches) - 1; idx >= 0; idx-- {
+		x -= calendarSwatchSize + calendarCellGap
+		c.swatches[idx].Move(fyne.NewPos(x, legendY))
+		c.swatches[idx].Resize(fyne.NewSize(calendarSwatchSize, calendarSwatchSize))
+	}
+	lowSize := c.legendLow.MinSize()
+	c.legendLow.Move(fyne.NewPos(x-theme.Padding()-lowSize.Width, legendY+calendarSwatchSize/2-lowSize.Height/2))
+
+	chart.tooltip.Resize(size)
+}
+
+func (c *calendarHeatmapRenderer) monthLabelHeight() float32 {
+	if len(c.monthLabels) == 0 {
+		return 0
+	}
+	return c.monthLabels[0].MinSize().Height
+}
+
+func (c *calendarHeatmapRenderer) dayLabelWidth() float32 {
+	width := float32(0)
+	for _, lbl := range c.dayLabels {
+		width = fyne.Max(width, lbl.MinSize().Width)
+	}
+	return width
+}
+
+func (c *calendarHeatmapRenderer) legendHeight() float32 {
+	return fyne.Max(calendarSwatchSize, c.legendHi.MinSize().Height)
+}
+
+func (c *calendarHeatmapRenderer) MinSize() fyne.Size {
+	titleSize := visibleTextSize(c.titleLbl)
+	grid := fyne.NewSize(float32(c.calendarHeatmap.weeks())*defaultCalendarCellSize, 7*defaultCalendarCellSize)
+	return fyne.NewSize(fyne.Max(titleSize.Width, grid.Width+c.dayLabelWidth())+3*theme.Padding(),
+		titleSize.Height+c.monthLabelHeight()+grid.Height+c.legendHeight()+4*theme.Padding())
+}
+
+func (c *calendarHeatmapRenderer) Objects() []fyne.CanvasObject {
+	cos := []fyne.CanvasObject{c.titleLbl}
+	for _, lbl := range c.dayLabels {
+		cos = append(cos, lbl)
+	}
+	for _, lbl := range c.monthLabels {
+		cos = append(cos, lbl)
+	}
+	for _, rect := range c.cells {
+		cos = append(cos, rect)
+	}
+	cos = append(cos, c.legendLow)
+	for _, swatch := range c.swatches {
+		cos = append(cos, swatch)
+	}
+	return append(cos, c.legendHi, c.calendarHeatmap.tooltip)
+}
+
+func (c *calendarHeatmapRenderer) Refresh() {
+	chart := c.calendarHeatmap
+	if chart.title != "" {
+		c.titleLbl.Text = chart.title
+		c.titleLbl.Refresh()
+		c.titleLbl.Show()
+	} else {
+		c.titleLbl.Hide()
+	}
+
+	c.dayLabels = nil
+	for _, name := range []string{"Mon", "Wed", "Fri", "Sun"} {
+		c.dayLabels = append(c.dayLabels, newCalendarText(name))
+	}
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, v := range chart.values {
+		low, high = math.Min(low, v), math.Max(high, v)
+	}
+	if math.IsInf(low, 1) {
+		low, high = 0, 0
+	}
+	low, high = chart.scale.domain(low, high)
+
+	c.cells = nil
+	c.cellDays = nil
+	c.monthLabels = nil
+	c.monthCols = nil
+	first := chart.firstWeekday()
+	if chart.weeks() > 0 {
+		for day := chart.start; !day.After(chart.end); day = day.AddDate(0, 0, 1) {
+			offset := daysBetween(first, day)
+			cellColor := theme.InputBackgroundColor()
+			if v, ok := chart.values[day]; ok {
+				cellColor = chart.scale.colorAt(v, low, high)
+			}
+			c.cells = append(c.cells, canvas.NewRectangle(cellColor))
+			c.cellDays = append(c.cellDays, offset)
+
+			if day.Day() == 1 || day.Equal(chart.start) {
+				col := offset / 7
+				// Skip a leading partial month when the next month's label would start in the following column.
+				if len(c.monthCols) > 0 && c.monthCols[len(c.monthCols)-1] >= col-1 {
+					c.monthLabels = c.monthLabels[:len(c.monthLabels)-1]
+					c.monthCols = c.monthCols[:len(c.monthCols)-1]
+				}
+				c.monthLabels = append(c.monthLabels, newCalendarText(day.Format("Jan")))
+				c.monthCols = append(c.monthCols, col)
+			}
+		}
+	}
+
+	steps := chart.scale.Steps
+	if steps <= 0 {
+		steps = defaultCalendarSteps
+	}
+	c.swatches = nil
+	for idx := 0; idx < steps; idx++ {
+		t := (float64(idx) + 0.5) / float64(steps)
+		c.swatches = append(c.swatches, canvas.NewRectangle(chart.scale.colorAt(low+t*(high-low), low, high)))
+	}
+	c.legendLow = newCalendarText(chart.hoverFormat(low))
+	c.legendHi = newCalendarText(chart.hoverFormat(high))
+}
+
+func newCalendarText(text string) *canvas.Text {
+	t := canvas.NewText(text, theme.ForegroundColor())
+	t.TextSize = theme.TextSize() - 2
+	return t
+}
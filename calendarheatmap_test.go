@@ -0,0 +1,43 @@
+package fynecharts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarHeatmapSumsDays(t *testing.T) {
+	// Wednesday the 3rd to Monday the 15th spans three Monday based weeks.
+	c := NewCalendarHeatmap(nil, "", nil)
+	c.UpdateDatedValues([]DatedValue{
+		{Date: time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), Value: 1},
+		{Date: time.Date(2024, 1, 3, 17, 0, 0, 0, time.UTC), Value: 2},
+		{Date: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), Value: 4},
+	})
+
+	if v := c.values[time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)]; v != 3 {
+		t.Error("expected values on the same day to be summed", v)
+	}
+	if !c.firstWeekday().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected the first column to start on Monday", c.firstWeekday())
+	}
+	if weeks := c.weeks(); weeks != 3 {
+		t.Error("expected 3 weeks", weeks)
+	}
+}
+
+func TestCalendarHeatmapMatchesDaysAcrossLocations(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+	c := NewCalendarHeatmap(nil, "", nil)
+	c.UpdateDatedValues([]DatedValue{
+		{Date: time.Date(2024, 3, 5, 8, 0, 0, 0, tokyo), Value: 1},
+		{Date: time.Date(2024, 3, 5, 20, 0, 0, 0, newYork), Value: 2},
+	})
+
+	if len(c.values) != 1 {
+		t.Fatal("expected one day", c.values)
+	}
+	if v := c.values[dayOf(time.Date(2024, 3, 5, 0, 0, 0, 0, time.Local))]; v != 3 {
+		t.Error("expected the same date in different locations to be summed", v)
+	}
+}
@@ -0,0 +1,331 @@
+package fynecharts
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"math"
+	"time"
+)
+
+const (
+	defaultCandleWidth      = 10
+	defaultVolumePaneRatio  = 0.2
+	defaultVolumeOpacity    = 0.5
+	candleTimeSpacingFactor = 0.7
+)
+
+// OHLC is a single period's open, high, low and close, Volume is only drawn when the volume pane is shown.
+type OHLC struct {
+	Open, High, Low, Close float64
+	Volume                 float64
+}
+
+func (o OHLC) up() bool {
+	return o.Close >= o.Open
+}
+
+type CandleStyle int
+
+const (
+	// CandleStyleCandlestick draws a filled body between open and close with a wick out to the high and low.
+	CandleStyleCandlestick CandleStyle = iota
+	// CandleStyleOHLC draws a line from low to high with the open ticked on the left and the close on the right.
+	CandleStyleOHLC
+)
+
+type CandlestickChart struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	data []OHLC
+	// times places each period proportionally along a time axis, when nil the periods are laid out in label columns.
+	times []time.Time
+
+	style       CandleStyle
+	candleWidth float32
+	showVolume  bool
+
+	upColor, downColor color.Color
+
+	hoverFormat    func(float64) string
+	timeTickFormat func(time.Time) string
+}
+
+func (c *CandlestickChart) CreateRenderer() fyne.WidgetRenderer {
+	bcr := c.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &candlestickChartRenderer{baseChartRenderer: bcr, candlestickChart: c, paneSeparator: canvas.NewLine(theme.ForegroundColor())}
+}
+
+func NewCandlestickChart(canvas fyne.Canvas, title string, labels []string, data []OHLC) *CandlestickChart {
+	cc := &CandlestickChart{BaseChart: newBaseChart(title, labels, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		data:        data,
+		candleWidth: defaultCandleWidth,
+		hoverFormat: defaultHoverFormat,
+	}
+	cc.ExtendBaseWidget(cc)
+	cc.Refresh()
+
+	return cc
+}
+
+func NewCandlestickChartWithTimes(canvas fyne.Canvas, title string, times []time.Time, data []OHLC) *CandlestickChart {
+	cc := NewCandlestickChart(canvas, title, nil, nil)
+	cc.UpdateTimeData(times, data)
+
+	return cc
+}
+
+func (c *CandlestickChart) UpdateData(labels []string, data []OHLC) {
+	c.xLabels = labels
+	c.times = nil
+	c.data = data
+	c.Refresh()
+}
+
+func (c *CandlestickChart) UpdateTimeData(times []time.Time, data []OHLC) {
+	c.xLabels = nil
+	c.times = times
+	c.data = data
+	c.Refresh()
+}
+
+func (c *CandlestickChart) SetCandleStyle(style CandleStyle) {
+	c.style = style
+	c.Refresh()
+}
+
+// SetCandleWidth sets the widest a candle is drawn, candles narrow to fit when the periods are closer together.
+func (c *CandlestickChart) SetCandleWidth(w float32) {
+	c.candleWidth = w
+	c.Refresh()
+}
+
+// SetShowVolume toggles a pane under the price axis with a volume bar per period.
+func (c *CandlestickChart) SetShowVolume(show bool) {
+	c.showVolume = show
+	c.Refresh()
+}
+
+// UpdateColors sets the colors of rising and falling periods, nil uses the theme's success and error colors.
+func (c *CandlestickChart) UpdateColors(up, down color.Color) {
+	c.upColor = up
+	c.downColor = down
+	c.Refresh()
+}
+
+func (c *CandlestickChart) UpdateHoverFormat(f func(float642 float64) string) {
+	c.hoverFormat = f
+	c.Refresh()
+}
+
+// UpdateTimeTickFormat overrides the automatic time axis label format, passing nil restores it.
+func (c *CandlestickChart) UpdateTimeTickFormat(f func(time.Time) string) {
+	c.timeTickFormat = f
+	c.Refresh()
+}
+
+func (c *CandlestickChart) colorFor(o OHLC) color.Color {
+	if o.up() {
+		if c.upColor != nil {
+			return c.upColor
+		}
+		return theme.SuccessColor()
+	}
+	if c.downColor != nil {
+		return c.downColor
+	}
+	return theme.ErrorColor()
+}
+
+// candleShape holds the objects drawing a single period, body is only used by candlesticks and the ticks by OHLC bars.
+type candleShape struct {
+	// hover is a transparent bar over the full high to low range showing the period's values.
+	hover               *bar
+	wick                *canvas.Line
+	body                *canvas.Rectangle
+	openTick, closeTick *canvas.Line
+	volume              *canvas.Rectangle
+}
+
+type candlestickChartRenderer struct {
+	*baseChartRenderer
+	candlestickChart *CandlestickChart
+
+	shapes        []candleShape
+	paneSeparator *canvas.Line
+	maxVolume     float64
+}
+
+func (c *candlestickChartRenderer) Destroy() {
+
+}
+
+func (c *candlestickChartRenderer) Layout(size fyne.Size) {
+	c.baseChartRenderer.Layout(size)
+
+	xOffset := c.xOffset()
+	availableHeight := c.availableHeight(size)
+	paneHeight := c.lowerPaneHeight(size)
+	paneBottom := size.Height - c.requiredBottomHeight()
+	plotBottom := paneBottom - paneHeight
+	yFor := func(v float64) float32 {
+		return plotBottom - availableHeight*c.yAxis.normalize(v)
+	}
+
+	width := c.candleSlotWidth(size, xOffset)
+	for idx, shape := range c.shapes {
+		o := c.candlestickChart.data[idx]
+		center := c.xCenter(idx, size, xOffset)
+		left, right := center-width/2, center+width/2
+
+		shape.hover.Move(fyne.NewPos(left, yFor(o.High)))
+		shape.hover.Resize(fyne.NewSize(width, yFor(o.Low)-yFor(o.High)))
+		shape.wick.Position1, shape.wick.Position2 = fyne.NewPos(center, yFor(o.High)), fyne.NewPos(center, yFor(o.Low))
+
+		top, bottom := yFor(math.Max(o.Open, o.Close)), yFor(math.Min(o.Open, o.Close))
+		shape.body.Move(fyne.NewPos(left, top))
+		// Keep a flat period visible as a line.
+		shape.body.Resize(fyne.NewSize(width, fyne.Max(bottom-top, 1)))
+		shape.openTick.Position1, shape.openTick.Position2 = fyne.NewPos(left, yFor(o.Open)), fyne.NewPos(center, yFor(o.Open))
+		shape.closeTick.Position1, shape.closeTick.Position2 = fyne.NewPos(center, yFor(o.Close)), fyne.NewPos(right, yFor(o.Close))
+
+		if c.maxVolume > 0 {
+			h := (paneHeight - theme.Padding()) * float32(o.Volume/c.maxVolume)
+			shape.volume.Move(fyne.NewPos(left, paneBottom-h))
+			shape.volume.Resize(fyne.NewSize(width, h))
+		}
+	}
+
+	c.paneSeparator.Position1 = fyne.NewPos(xOffset, plotBottom)
+	c.paneSeparator.Position2 = fyne.NewPos(size.Width-theme.Padding()-c.insets.right, plotBottom)
+}
+
+// candleSlotWidth narrows the candles so neighbouring periods never overlap.
+func (c *candlestickChartRenderer) candleSlotWidth(size fyne.Size, xOffset float32) float32 {
+	width := c.candlestickChart.candleWidth
+	if c.xAxis == nil {
+		return fyne.Min(width, c.columnWidth(size, xOffset)-theme.Padding())
+	}
+	for idx := 1; idx < len(c.shapes); idx++ {
+		gap := abs32(c.xCenter(idx, size, xOffset) - c.xCenter(idx-1, size, xOffset))
+		if gap > 0 {
+			width = fyne.Min(width, gap*candleTimeSpacingFactor)
+		}
+	}
+	return width
+}
+
+func (c *candlestickChartRenderer) MinSize() fyne.Size {
+	xCellWidth := fyne.Max(c.xLblMax.Width, c.candlestickChart.candleWidth) + 2
+	minHeight := c.candlestickChart.minHeight
+	if c.lowerPaneRatio > 0 {
+		minHeight /= 1 - c.lowerPaneRatio
+	}
+	return fyne.NewSize(c.xOffset()+float32(len(c.candlestickChart.xLabels))*xCellWidth,
+		c.requiredTopHeight()+c.requiredBottomHeight()+minHeight)
+}
+
+func (c *candlestickChartRenderer) Objects() []fyne.CanvasObject {
+	cos := c.baseChartRenderer.Objects()
+	if c.lowerPaneRatio > 0 {
+		cos = append(cos, c.paneSeparator)
+		for _, shape := range c.shapes {
+			cos = append(cos, shape.volume)
+		}
+	}
+	for _, shape := range c.shapes {
+		cos = append(cos, shape.wick)
+		if c.candlestickChart.style == CandleStyleOHLC {
+			cos = append(cos, shape.openTick, shape.closeTick)
+		} else {
+			cos = append(cos, shape.body)
+		}
+	}
+	// Hover bars go last so their text is drawn over neighbouring candles.
+	for _, shape := range c.shapes {
+		cos = append(cos, shape.hover)
+	}
+	return cos
+}
+
+func (c *candlestickChartRenderer) Refresh() {
+	c.yAxis = axis{normalizer: linearNormalizer{}}
+	c.shapes = nil
+	c.maxVolume = 0
+	c.lowerPaneRatio = 0
+	if c.candlestickChart.showVolume {
+		c.lowerPaneRatio = defaultVolumePaneRatio
+	}
+
+	c.refreshTimeAxis(c.candlestickChart.times, c.candlestickChart.timeTickFormat)
+	c.padTimeAxis()
+	for idx, o := range c.candlestickChart.data {
+		if c.xAxis != nil && idx >= len(c.candlestickChart.times) {
+			break
+		}
+		c.yAxis.include(o.Low)
+		c.yAxis.include(o.High)
+		c.maxVolume = math.Max(c.maxVolume, o.Volume)
+
+		col := c.candlestickChart.colorFor(o)
+		volumeColor := color.NRGBAModel.Convert(col).(color.NRGBA)
+		volumeColor.A = uint8(float32(volumeColor.A) * defaultVolumeOpacity)
+		shape := candleShape{
+			hover:     newBar(c.candlestickChart.canvas, c.hoverValue(idx, o), color.Transparent),
+			wick:      newCandleLine(col),
+			body:      canvas.NewRectangle(col),
+			openTick:  newCandleLine(col),
+			closeTick: newCandleLine(col),
+			volume:    canvas.NewRectangle(volumeColor),
+		}
+		c.shapes = append(c.shapes, shape)
+	}
+	c.yAxis.dataRange = c.yAxis.max - c.yAxis.min
+
+	c.baseChartRenderer.Refresh()
+}
+
+// padTimeAxis widens the time axis by half the shortest period so the first and last candles are drawn whole.
+func (c *candlestickChartRenderer) padTimeAxis() {
+	times := c.candlestickChart.times
+	if c.xAxis == nil || len(times) < 2 {
+		return
+	}
+	shortest := math.Inf(1)
+	for idx := 1; idx < len(times); idx++ {
+		if gap := math.Abs(timeValue(times[idx]) - timeValue(times[idx-1])); gap > 0 {
+			shortest = math.Min(shortest, gap)
+		}
+	}
+	if math.IsInf(shortest, 1) {
+		return
+	}
+	c.xAxis.min -= shortest / 2
+	c.xAxis.max += shortest / 2
+	c.xAxis.dataRange = c.xAxis.max - c.xAxis.min
+}
+
+func (c *candlestickChartRenderer) hoverValue(idx int, o OHLC) string {
+	f := c.candlestickChart.hoverFormat
+	display := fmt.Sprintf("Open: %s\nHigh: %s\nLow: %s\nClose: %s", f(o.Open), f(o.High), f(o.Low), f(o.Close))
+	if c.candlestickChart.showVolume {
+		display += "\nVolume: " + f(o.Volume)
+	}
+	if c.xAxis != nil {
+		display = c.formatTime(c.candlestickChart.times[idx]) + "\n" + display
+	} else if idx < len(c.candlestickChart.xLabels) {
+		display = c.candlestickChart.xLabels[idx] + "\n" + display
+	}
+	return display
+}
+
+func newCandleLine(c color.Color) *canvas.Line {
+	l := canvas.NewLine(c)
+	l.StrokeWidth = 1.5
+	return l
+}
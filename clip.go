@@ -0,0 +1,76 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// The clip functions cut lines and shapes to the band between the y values top and bottom, the height of the plot,
+// so that values beyond a fixed axis range leave the plot at its edge rather than being drawn over the labels.
+
+// clipSegment returns the part of the segment from a to b within the band, ok is false when none of it is.
+func clipSegment(a, b fyne.Position, top, bottom float32) (fyne.Position, fyne.Position, bool) {
+	if a.Y < top && b.Y < top || a.Y > bottom && b.Y > bottom {
+		return a, b, false
+	}
+	return cutAt(a, b, top, bottom), cutAt(b, a, top, bottom), true
+}
+
+// cutAt moves a along the segment towards b until it is within the band, b must not lie beyond the same edge.
+func cutAt(a, b fyne.Position, top, bottom float32) fyne.Position {
+	edge := a.Y
+	if a.Y < top {
+		edge = top
+	} else if a.Y > bottom {
+		edge = bottom
+	}
+	if edge == a.Y {
+		return a
+	}
+	t := (edge - a.Y) / (b.Y - a.Y)
+	return fyne.NewPos(a.X+(b.X-a.X)*t, edge)
+}
+
+// clipPath splits the path into the runs within the band, cutting segments where they cross its edges.
+func clipPath(points []fyne.Position, top, bottom float32) [][]fyne.Position {
+	var runs [][]fyne.Position
+	var run []fyne.Position
+	for idx := 1; idx < len(points); idx++ {
+		a, b, ok := clipSegment(points[idx-1], points[idx], top, bottom)
+		if !ok {
+			continue
+		}
+		if len(run) == 0 || run[len(run)-1] != a {
+			if len(run) > 1 {
+				runs = append(runs, run)
+			}
+			run = []fyne.Position{a}
+		}
+		run = append(run, b)
+	}
+	if len(run) > 1 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// clipPolygon cuts the polygon to the band, one edge at a time.
+func clipPolygon(points []fyne.Position, top, bottom float32) []fyne.Position {
+	clipped := clipPolygonEdge(points, func(p fyne.Position) bool { return p.Y >= top }, top)
+	return clipPolygonEdge(clipped, func(p fyne.Position) bool { return p.Y <= bottom }, bottom)
+}
+
+// clipPolygonEdge keeps the part of the polygon for which inside holds, cutting its sides where they cross edge.
+func clipPolygonEdge(points []fyne.Position, inside func(fyne.Position) bool, edge float32) []fyne.Position {
+	var clipped []fyne.Position
+	for idx, b := range points {
+		a := points[(idx+len(points)-1)%len(points)]
+		if inside(a) != inside(b) {
+			t := (edge - a.Y) / (b.Y - a.Y)
+			clipped = append(clipped, fyne.NewPos(a.X+(b.X-a.X)*t, edge))
+		}
+		if inside(b) {
+			clipped = append(clipped, b)
+		}
+	}
+	return clipped
+}
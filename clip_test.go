@@ -0,0 +1,40 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"testing"
+)
+
+func TestClipSegment(t *testing.T) {
+	a, b, ok := clipSegment(fyne.NewPos(0, -50), fyne.NewPos(100, 50), 0, 100)
+	if !ok || a != fyne.NewPos(50, 0) || b != fyne.NewPos(100, 50) {
+		t.Error("expected the segment to be cut where it enters the band", a, b)
+	}
+	if _, _, ok := clipSegment(fyne.NewPos(0, 120), fyne.NewPos(100, 150), 0, 100); ok {
+		t.Error("expected a segment below the band to be left out")
+	}
+}
+
+func TestClipPathSplitsRuns(t *testing.T) {
+	path := []fyne.Position{{X: 0, Y: 50}, {X: 10, Y: 150}, {X: 20, Y: 150}, {X: 30, Y: 50}}
+	runs := clipPath(path, 0, 100)
+	if len(runs) != 2 {
+		t.Fatal("expected the path to be split where it leaves the band", runs)
+	}
+	if runs[0][1] != fyne.NewPos(5, 100) || runs[1][0] != fyne.NewPos(25, 100) {
+		t.Error("expected the runs to end at the edge of the band", runs)
+	}
+}
+
+func TestClipPolygon(t *testing.T) {
+	square := []fyne.Position{{X: 0, Y: -50}, {X: 100, Y: -50}, {X: 100, Y: 50}, {X: 0, Y: 50}}
+	clipped := clipPolygon(square, 0, 100)
+	for _, pt := range clipped {
+		if pt.Y < 0 || pt.Y > 100 {
+			t.Error("expected every point within the band", clipped)
+		}
+	}
+	if len(clipped) != 4 {
+		t.Error("expected the square to be cut to a rectangle", clipped)
+	}
+}
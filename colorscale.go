@@ -0,0 +1,103 @@
+package fynecharts
+
+import (
+	"image/color"
+	"math"
+)
+
+// Palette lists the colors a ColorScale blends between, from the lowest value to the highest.
+type Palette []color.Color
+
+var (
+	// PaletteSequential runs from dark purple through teal to yellow, for values that only grow in one direction.
+	PaletteSequential = Palette{
+		color.NRGBA{R: 0x44, G: 0x01, B: 0x54, A: 0xff},
+		color.NRGBA{R: 0x3b, G: 0x52, B: 0x8b, A: 0xff},
+		color.NRGBA{R: 0x21, G: 0x91, B: 0x8c, A: 0xff},
+		color.NRGBA{R: 0x5e, G: 0xc9, B: 0x62, A: 0xff},
+		color.NRGBA{R: 0xfd, G: 0xe7, B: 0x25, A: 0xff},
+	}
+	// PaletteGreens runs from dark to bright green, the default for calendar heatmaps.
+	PaletteGreens = Palette{
+		color.NRGBA{R: 0x0e, G: 0x44, B: 0x29, A: 0xff},
+		color.NRGBA{R: 0x00, G: 0x6d, B: 0x32, A: 0xff},
+		color.NRGBA{R: 0x26, G: 0xa6, B: 0x41, A: 0xff},
+		color.NRGBA{R: 0x39, G: 0xd3, B: 0x53, A: 0xff},
+	}
+	// PaletteDiverging runs from blue through white to red, for values either side of a midpoint.
+	PaletteDiverging = Palette{
+		color.NRGBA{R: 0x21, G: 0x66, B: 0xac, A: 0xff},
+		color.NRGBA{R: 0x92, G: 0xc5, B: 0xde, A: 0xff},
+		color.NRGBA{R: 0xf7, G: 0xf7, B: 0xf7, A: 0xff},
+		color.NRGBA{R: 0xf4, G: 0xa5, B: 0x82, A: 0xff},
+		color.NRGBA{R: 0xb2, G: 0x18, B: 0x2b, A: 0xff},
+	}
+)
+
+// at blends the palette at t, from 0 for the first color to 1 for the last.
+func (p Palette) at(t float64) color.NRGBA {
+	if len(p) == 0 {
+		return color.NRGBA{}
+	}
+	t = math.Min(math.Max(t, 0), 1)
+	pos := t * float64(len(p)-1)
+	idx := int(pos)
+	if idx >= len(p)-1 {
+		return color.NRGBAModel.Convert(p[len(p)-1]).(color.NRGBA)
+	}
+
+	a := color.NRGBAModel.Convert(p[idx]).(color.NRGBA)
+	b := color.NRGBAModel.Convert(p[idx+1]).(color.NRGBA)
+	frac := pos - float64(idx)
+	blend := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*frac))
+	}
+	return color.NRGBA{R: blend(a.R, b.R), G: blend(a.G, b.G), B: blend(a.B, b.B), A: blend(a.A, b.A)}
+}
+
+// ColorScale maps values onto a Palette.
+type ColorScale struct {
+	// Palette defaults to PaletteSequential, or PaletteDiverging when Diverging is set.
+	Palette Palette
+	// Diverging centers the palette on Midpoint, so values equally far either side get equally strong colors.
+	Diverging bool
+	Midpoint  float64
+	// Steps quantizes the scale into that many flat colors, 0 blends continuously.
+	Steps int
+}
+
+func (s ColorScale) palette() Palette {
+	if s.Palette != nil {
+		return s.Palette
+	}
+	if s.Diverging {
+		return PaletteDiverging
+	}
+	return PaletteSequential
+}
+
+// domain is the range of values spanned by the palette for data between min and max,
+// which is widened to be symmetric about the midpoint when the scale is diverging.
+func (s ColorScale) domain(min, max float64) (float64, float64) {
+	if s.Diverging {
+		extent := math.Max(math.Abs(max-s.Midpoint), math.Abs(min-s.Midpoint))
+		return s.Midpoint - extent, s.Midpoint + extent
+	}
+	return min, max
+}
+
+// colorAt is the color of v on a scale whose domain is low to high.
+func (s ColorScale) colorAt(v, low, high float64) color.NRGBA {
+	t := 0.5
+	if high > low {
+		t = (v - low) / (high - low)
+	}
+	if s.Steps > 0 {
+		step := math.Min(math.Floor(t*float64(s.Steps)), float64(s.Steps-1))
+		t = 1
+		if s.Steps > 1 {
+			t = math.Max(step, 0) / float64(s.Steps-1)
+		}
+	}
+	return s.palette().at(t)
+}
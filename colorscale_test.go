@@ -0,0 +1,27 @@
+package fynecharts
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestColorScaleDivergingDomain(t *testing.T) {
+	low, high := ColorScale{Diverging: true}.domain(-2, 8)
+	if low != -8 || high != 8 {
+		t.Error("expected domain symmetric about zero", low, high)
+	}
+}
+
+func TestColorScaleSteps(t *testing.T) {
+	black, white := color.NRGBA{A: 0xff}, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	s := ColorScale{Palette: Palette{black, white}, Steps: 2}
+	if c := s.colorAt(0.4, 0, 1); c != black {
+		t.Error("expected the lower step to be black", c)
+	}
+	if c := s.colorAt(0.6, 0, 1); c != white {
+		t.Error("expected the upper step to be white", c)
+	}
+	if c := s.colorAt(1, 0, 1); c != white {
+		t.Error("expected the maximum to be in the last step", c)
+	}
+}
@@ -1,13 +1,23 @@
 package fynecharts
 
-import "fmt"
+import (
+	"fmt"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+)
 
 const (
 	defaultBarWidth           = 25
 	defaultMinHeight          = 100
 	defaultSuggestedTickCount = 4
+	defaultMarkerDiameter     = 10
+	defaultMinBubbleDiameter  = 4
+	defaultMaxBubbleDiameter  = 48
+	sizeLegendCount           = 3
 )
 
+var defaultSeriesColorNames = []string{theme.ColorOrange, theme.ColorGreen, theme.ColorPurple, theme.ColorRed, theme.ColorYellow, theme.ColorBrown, theme.ColorBlue, theme.ColorGray}
+
 func defaultHoverFormat(input float64) string {
 	return fmt.Sprintf("%.2f", input)
 }
@@ -15,3 +25,15 @@ func defaultHoverFormat(input float64) string {
 func defaultTickFormat(input float64) string {
 	return fmt.Sprintf("%.1f", input)
 }
+
+// defaultSeriesColor returns c when it is set, otherwise the primary color for the first series
+// followed by the theme's named primary colors.
+func defaultSeriesColor(idx int, c color.Color) color.Color {
+	if c != nil {
+		return c
+	}
+	if idx == 0 {
+		return theme.PrimaryColor()
+	}
+	return theme.PrimaryColorNamed(defaultSeriesColorNames[(idx-1)%len(defaultSeriesColorNames)])
+}
@@ -6,6 +6,16 @@ import (
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"image/color"
+)
+
+type MarkerShape int
+
+const (
+	MarkerCircle MarkerShape = iota
+	MarkerSquare
+	MarkerTriangle
+	MarkerCross
 )
 
 type dot struct {
@@ -15,6 +25,8 @@ type dot struct {
 	displayValue string
 	showValue    bool
 	pos          fyne.Position
+	shape        MarkerShape
+	color        color.Color
 }
 
 func (d *dot) MouseIn(event *desktop.MouseEvent) {
@@ -48,24 +60,70 @@ func (d *dot) updateDisplayValue(v string) {
 func (d *dot) CreateRenderer() fyne.WidgetRenderer {
 	return &dotRenderer{
 		d:       d,
-		circle:  canvas.NewCircle(theme.PrimaryColor()),
+		marker:  newMarker(d.shape, d.color),
 		wrapper: canvas.NewRectangle(theme.BackgroundColor()),
 		display: widget.NewLabel(d.displayValue),
 	}
 }
 
-func newDot(canvas fyne.Canvas, value string) *dot {
-	d := &dot{canvas: canvas, displayValue: value}
+func newDot(canvas fyne.Canvas, value string, shape MarkerShape, color color.Color) *dot {
+	d := &dot{canvas: canvas, displayValue: value, shape: shape, color: color}
 	d.ExtendBaseWidget(d)
 	d.Refresh()
 
 	return d
 }
 
+// newMarker builds the canvas objects drawing shape, they are all sized to fill the dot in dotRenderer.Layout.
+func newMarker(shape MarkerShape, c color.Color) []fyne.CanvasObject {
+	switch shape {
+	case MarkerSquare:
+		return []fyne.CanvasObject{canvas.NewRectangle(c)}
+	case MarkerTriangle:
+		// The raster picks its image type from the first pixel, so both colors must share the NRGBA model.
+		fill := color.NRGBAModel.Convert(c)
+		return []fyne.CanvasObject{canvas.NewRasterWithPixels(func(x, y, w, h int) color.Color {
+			halfWidth := float32(w) / 2
+			if abs32(float32(x)+0.5-halfWidth) <= halfWidth*(float32(y)+0.5)/float32(h) {
+				return fill
+			}
+			return color.NRGBA{}
+		})}
+	case MarkerCross:
+		first, second := canvas.NewLine(c), canvas.NewLine(c)
+		first.StrokeWidth = 2
+		second.StrokeWidth = 2
+		return []fyne.CanvasObject{first, second}
+	default:
+		return []fyne.CanvasObject{canvas.NewCircle(c)}
+	}
+}
+
+// layoutMarker fits the objects built by newMarker into the square at pos.
+func layoutMarker(shape MarkerShape, marker []fyne.CanvasObject, pos fyne.Position, size fyne.Size) {
+	if shape == MarkerCross {
+		first, second := marker[0].(*canvas.Line), marker[1].(*canvas.Line)
+		first.Position1, first.Position2 = pos, pos.AddXY(size.Width, size.Height)
+		second.Position1, second.Position2 = pos.AddXY(0, size.Height), pos.AddXY(size.Width, 0)
+		return
+	}
+	for _, m := range marker {
+		m.Move(pos)
+		m.Resize(size)
+	}
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
 type dotRenderer struct {
 	d *dot
 
-	circle  *canvas.Circle
+	marker  []fyne.CanvasObject
 	wrapper *canvas.Rectangle
 	display *widget.Label
 }
@@ -75,17 +133,17 @@ func (d *dotRenderer) Destroy() {
 }
 
 func (d *dotRenderer) Layout(size fyne.Size) {
-	d.circle.Resize(size)
+	layoutMarker(d.d.shape, d.marker, fyne.NewPos(0, 0), size)
 	d.wrapper.Resize(d.display.MinSize())
 	d.display.Resize(d.display.MinSize())
 }
 
 func (d *dotRenderer) MinSize() fyne.Size {
-	return d.circle.MinSize()
+	return d.marker[0].MinSize()
 }
 
 func (d *dotRenderer) Objects() []fyne.CanvasObject {
-	return []fyne.CanvasObject{d.circle, d.wrapper, d.display}
+	return append(append([]fyne.CanvasObject{}, d.marker...), d.wrapper, d.display)
 }
 
 func (d *dotRenderer) Refresh() {
@@ -0,0 +1,373 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image"
+	"image/color"
+	"log"
+	"math"
+)
+
+const (
+	defaultGaugeMinRadius  = 60
+	defaultMinorTickCount  = 4
+	gaugeSweep             = 4 * math.Pi / 3
+	gaugeTrackRatio        = 0.18
+	gaugeBandRatio         = 0.3
+	gaugeMajorTickLength   = 8
+	gaugeMinorTickLength   = 4
+	gaugeNeedleHubDiameter = 12
+)
+
+// GaugeBand colors the part of the gauge's arc from From to To, marking thresholds such as warning and critical.
+type GaugeBand struct {
+	From, To float64
+	Color    color.Color
+}
+
+type GaugeStyle int
+
+const (
+	// GaugeNeedle points a needle at the value over an arc colored by the bands.
+	GaugeNeedle GaugeStyle = iota
+	// GaugeFilledArc fills the arc up to the value, drawing the bands in a thin ring around it.
+	GaugeFilledArc
+)
+
+// Gauge shows a single value on an arc running clockwise from min to max, with ticks around the inside
+// and the value written in the middle.
+type Gauge struct {
+	widget.BaseWidget
+
+	title    string
+	value    float64
+	min, max float64
+	bands    []GaugeBand
+	style    GaugeStyle
+
+	suggestedTickCount int
+	minorTickCount     int
+	tickGenerator      TickGenerator
+
+	tickFormat  func(input float64) string
+	valueFormat func(input float64) string
+}
+
+func NewGauge(title string, min, max, value float64) *Gauge {
+	g := &Gauge{title: title, min: min, max: max, value: value,
+		suggestedTickCount: defaultSuggestedTickCount,
+		minorTickCount:     defaultMinorTickCount,
+		tickFormat:         defaultTickFormat,
+		valueFormat:        defaultTickFormat,
+	}
+	g.ExtendBaseWidget(g)
+	g.Refresh()
+
+	return g
+}
+
+func (g *Gauge) CreateRenderer() fyne.WidgetRenderer {
+	titleLbl := canvas.NewText(g.title, theme.ForegroundColor())
+	titleLbl.TextSize = theme.TextSize() + 6
+	titleLbl.Hide()
+	valueLbl := canvas.NewText("", theme.ForegroundColor())
+	valueLbl.TextSize = 2 * theme.TextSize()
+	valueLbl.TextStyle.Bold = true
+	needle := canvas.NewLine(theme.ForegroundColor())
+	needle.StrokeWidth = 3
+
+	gr := &gaugeRenderer{gauge: g,
+		titleLbl: titleLbl,
+		valueLbl: valueLbl,
+		needle:   needle,
+		hub:      canvas.NewCircle(theme.ForegroundColor()),
+	}
+	gr.arc = canvas.NewRaster(gr.generate)
+	return gr
+}
+
+func (g *Gauge) SetValue(value float64) {
+	g.value = value
+	g.Refresh()
+}
+
+func (g *Gauge) SetRange(min, max float64) {
+	g.min = min
+	g.max = max
+	g.Refresh()
+}
+
+func (g *Gauge) SetBands(bands []GaugeBand) {
+	g.bands = bands
+	g.Refresh()
+}
+
+func (g *Gauge) SetStyle(style GaugeStyle) {
+	g.style = style
+	g.Refresh()
+}
+
+// SetMinorTickCount sets how many minor ticks are drawn between each pair of major ticks.
+func (g *Gauge) SetMinorTickCount(count int) {
+	g.minorTickCount = count
+	g.Refresh()
+}
+
+func (g *Gauge) UpdateSuggestedTickCount(count int) {
+	g.suggestedTickCount = count
+	g.Refresh()
+}
+
+// SetTickGenerator picks the major tick values, passing nil restores the default.
+func (g *Gauge) SetTickGenerator(tg TickGenerator) {
+	g.tickGenerator = tg
+	g.Refresh()
+}
+
+func (g *Gauge) UpdateTickFormat(f func(input float64) string) {
+	g.tickFormat = f
+	g.Refresh()
+}
+
+// UpdateValueFormat sets the format of the value in the middle of the gauge.
+func (g *Gauge) UpdateValueFormat(f func(input float64) string) {
+	g.valueFormat = f
+	g.Refresh()
+}
+
+// angleOf is the clockwise angle from twelve o'clock of v, the arc running from -sweep/2 to sweep/2.
+func (g *Gauge) angleOf(v float64) float64 {
+	t := 0.0
+	if g.max > g.min {
+		t = math.Min(math.Max((v-g.min)/(g.max-g.min), 0), 1)
+	}
+	return -gaugeSweep/2 + t*gaugeSweep
+}
+
+// valueAt is the value at angle, the inverse of angleOf.
+func (g *Gauge) valueAt(angle float64) float64 {
+	return g.min + (angle+gaugeSweep/2)/gaugeSweep*(g.max-g.min)
+}
+
+// bandColor is the color of the last band containing v, nil when there is none.
+func (g *Gauge) bandColor(v float64) color.Color {
+	var c color.Color
+	for _, band := range g.bands {
+		if v >= math.Min(band.From, band.To) && v <= math.Max(band.From, band.To) {
+			c = band.Color
+		}
+	}
+	return c
+}
+
+type gaugeRenderer struct {
+	gauge *Gauge
+
+	titleLbl   *canvas.Text
+	arc        *canvas.Raster
+	majorTicks []*canvas.Line
+	majorVals  []float64
+	tickLabels []*canvas.Text
+	minorTicks []*canvas.Line
+	minorVals  []float64
+	needle     *canvas.Line
+	hub        *canvas.Circle
+	valueLbl   *canvas.Text
+
+	// center and radius are the outside of the track, set during layout for generate.
+	center fyne.Position
+	radius float32
+}
+
+func (g *gaugeRenderer) Destroy() {
+
+}
+
+func (g *gaugeRenderer) Layout(size fyne.Size) {
+	titleSize := visibleTextSize(g.titleLbl)
+	g.titleLbl.Move(fyne.NewPos(size.Width/2-titleSize.Width/2, theme.Padding()))
+	g.titleLbl.Resize(titleSize)
+
+	// The arc reaches down to half the radius below its center, at the ends of the sweep.
+	top := titleSize.Height + 2*theme.Padding()
+	available := fyne.NewSize(size.Width-2*theme.Padding(), size.Height-top-theme.Padding())
+	radius := fyne.Max(fyne.Min(available.Width/2, available.Height/1.5), 0)
+	g.center = fyne.NewPos(size.Width/2, top+radius+(available.Height-1.5*radius)/2)
+	g.radius = radius
+
+	g.arc.Move(fyne.NewPos(0, 0))
+	g.arc.Resize(size)
+
+	track := radius * gaugeTrackRatio
+	tickStart := radius - track - theme.Padding()/2
+	for idx, tk := range g.majorTicks {
+		angle := g.gauge.angleOf(g.majorVals[idx])
+		tk.Position1 = pointAt(g.center, angle, tickStart)
+		tk.Position2 = pointAt(g.center, angle, tickStart-gaugeMajorTickLength)
+
+		lbl := g.tickLabels[idx]
+		lblSize := lbl.MinSize()
+		// Push labels in by their half diagonal so they clear the ticks whatever the angle.
+		inset := gaugeMajorTickLength + theme.Padding()/2 + float32(math.Hypot(float64(lblSize.Width), float64(lblSize.Height)))/2
+		lbl.Move(pointAt(g.center, angle, tickStart-inset).SubtractXY(lblSize.Width/2, lblSize.Height/2))
+	}
+	for idx, tk := range g.minorTicks {
+		angle := g.gauge.angleOf(g.minorVals[idx])
+		tk.Position1 = pointAt(g.center, angle, tickStart)
+		tk.Position2 = pointAt(g.center, angle, tickStart-gaugeMinorTickLength)
+	}
+
+	valueSize := g.valueLbl.MinSize()
+	valuePos := g.center.SubtractXY(valueSize.Width/2, valueSize.Height/2)
+	if g.gauge.style == GaugeNeedle {
+		g.needle.Position1 = g.center
+		g.needle.Position2 = pointAt(g.center, g.gauge.angleOf(g.gauge.value), tickStart-gaugeMajorTickLength)
+		g.hub.Move(g.center.SubtractXY(gaugeNeedleHubDiameter/2, gaugeNeedleHubDiameter/2))
+		g.hub.Resize(fyne.NewSize(gaugeNeedleHubDiameter, gaugeNeedleHubDiameter))
+		// Drop the value below the hub, into the gap between the ends of the arc.
+		valuePos = valuePos.AddXY(0, radius/4+valueSize.Height/2)
+	}
+	g.valueLbl.Move(valuePos)
+	g.valueLbl.Resize(valueSize)
+}
+
+func (g *gaugeRenderer) MinSize() fyne.Size {
+	titleSize := visibleTextSize(g.titleLbl)
+	diameter := float32(2 * defaultGaugeMinRadius)
+	return fyne.NewSize(fyne.Max(titleSize.Width, diameter)+2*theme.Padding(),
+		titleSize.Height+diameter*0.75+3*theme.Padding())
+}
+
+func (g *gaugeRenderer) Objects() []fyne.CanvasObject {
+	cos := []fyne.CanvasObject{g.titleLbl, g.arc}
+	for _, tk := range g.minorTicks {
+		cos = append(cos, tk)
+	}
+	for _, tk := range g.majorTicks {
+		cos = append(cos, tk)
+	}
+	for _, lbl := range g.tickLabels {
+		cos = append(cos, lbl)
+	}
+	if g.gauge.style == GaugeNeedle {
+		cos = append(cos, g.needle, g.hub)
+	}
+	return append(cos, g.valueLbl)
+}
+
+func (g *gaugeRenderer) Refresh() {
+	gauge := g.gauge
+	if gauge.title != "" {
+		g.titleLbl.Text = gauge.title
+		g.titleLbl.Refresh()
+		g.titleLbl.Show()
+	} else {
+		g.titleLbl.Hide()
+	}
+	g.valueLbl.Text = gauge.valueFormat(gauge.value)
+	g.valueLbl.Refresh()
+
+	g.majorTicks = nil
+	g.majorVals = nil
+	g.tickLabels = nil
+	g.minorTicks = nil
+	g.minorVals = nil
+	if gauge.max > gauge.min {
+		ticks, err := orDefaultTicks(gauge.tickGenerator).Ticks(gauge.min, gauge.max, gauge.suggestedTickCount)
+		if err != nil {
+			log.Println("error generating gauge ticks")
+		}
+		ticks = ticksInRange(ticks, gauge.min, gauge.max)
+		for idx, tk := range ticks {
+			g.majorTicks = append(g.majorTicks, newGaugeTick(2))
+			g.majorVals = append(g.majorVals, tk)
+			lbl := canvas.NewText(gauge.tickFormat(tk), theme.ForegroundColor())
+			lbl.TextSize = theme.TextSize() - 2
+			g.tickLabels = append(g.tickLabels, lbl)
+
+			if idx == 0 {
+				continue
+			}
+			step := (tk - ticks[idx-1]) / float64(gauge.minorTickCount+1)
+			for minor := 1; minor <= gauge.minorTickCount; minor++ {
+				g.minorTicks = append(g.minorTicks, newGaugeTick(1))
+				g.minorVals = append(g.minorVals, ticks[idx-1]+float64(minor)*step)
+			}
+		}
+	}
+
+	g.arc.Refresh()
+}
+
+func newGaugeTick(width float32) *canvas.Line {
+	l := canvas.NewLine(theme.ForegroundColor())
+	l.StrokeWidth = width
+	return l
+}
+
+// generate paints the track, with the bands on it for a needle or around it for a filled arc.
+func (g *gaugeRenderer) generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	size := g.arc.Size()
+	gauge := g.gauge
+	if size.Width <= 0 || size.Height <= 0 || g.radius <= 0 {
+		return img
+	}
+
+	trackColor := color.NRGBAModel.Convert(theme.InputBackgroundColor()).(color.NRGBA)
+	fillColor := color.NRGBAModel.Convert(theme.PrimaryColor()).(color.NRGBA)
+	if c := gauge.bandColor(gauge.value); c != nil {
+		fillColor = color.NRGBAModel.Convert(c).(color.NRGBA)
+	}
+	bandColor := func(v float64) (color.NRGBA, bool) {
+		if c := gauge.bandColor(v); c != nil {
+			return color.NRGBAModel.Convert(c).(color.NRGBA), true
+		}
+		return color.NRGBA{}, false
+	}
+
+	track := float64(g.radius * gaugeTrackRatio)
+	outer := float64(g.radius)
+	inner := outer - track
+	bandInner := outer
+	if gauge.style == GaugeFilledArc && len(gauge.bands) > 0 {
+		// Make room for the band ring outside the track.
+		bandInner = outer - track*gaugeBandRatio
+		outer, inner = bandInner-1, bandInner-1-track*(1-gaugeBandRatio)
+	}
+	valueAngle := gauge.angleOf(gauge.value)
+
+	scaleX, scaleY := size.Width/float32(w), size.Height/float32(h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			angle, distance := polarFrom(g.center, (float32(x)+0.5)*scaleX, (float32(y)+0.5)*scaleY)
+			if angle > math.Pi {
+				angle -= 2 * math.Pi
+			}
+			if angle < -gaugeSweep/2 || angle > gaugeSweep/2 {
+				continue
+			}
+
+			switch {
+			case distance >= inner && distance <= outer:
+				c := trackColor
+				if gauge.style == GaugeFilledArc {
+					if angle <= valueAngle && gauge.value > gauge.min {
+						c = fillColor
+					}
+				} else if bc, ok := bandColor(gauge.valueAt(angle)); ok {
+					c = bc
+				}
+				img.SetNRGBA(x, y, c)
+			case gauge.style == GaugeFilledArc && distance >= bandInner && distance <= float64(g.radius):
+				if bc, ok := bandColor(gauge.valueAt(angle)); ok {
+					img.SetNRGBA(x, y, bc)
+				}
+			}
+		}
+	}
+	return img
+}
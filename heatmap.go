@@ -0,0 +1,328 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image/color"
+	"log"
+	"math"
+)
+
+const (
+	// heatmapRasterThreshold is the most cells drawn as rectangles, larger grids are painted into a single raster.
+	heatmapRasterThreshold = 1024
+	heatmapCellGap         = 1
+	heatmapLegendWidth     = 14
+	defaultHeatmapCellSize = 24
+)
+
+// Heatmap colors a grid of values, values[row][col] belonging to yLabels[row] and xLabels[col].
+// The first row is drawn at the top, NaN marks a missing cell which is left empty.
+type Heatmap struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	yLabels []string
+	values  [][]float64
+
+	scale       ColorScale
+	hoverFormat func(float64) string
+	onTouched   func(row, col int)
+
+	tooltip *tooltip
+	// plotPos and cellSize are set during layout to find the cell under the pointer.
+	plotPos  fyne.Position
+	cellSize fyne.Size
+}
+
+func (h *Heatmap) CreateRenderer() fyne.WidgetRenderer {
+	bcr := h.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &heatmapRenderer{baseChartRenderer: bcr, heatmap: h}
+}
+
+func NewHeatmap(canvas fyne.Canvas, title string, xLabels, yLabels []string, values [][]float64) *Heatmap {
+	h := &Heatmap{BaseChart: newBaseChart(title, xLabels, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		yLabels:     yLabels,
+		values:      values,
+		hoverFormat: defaultHoverFormat,
+		tooltip:     newTooltip(),
+	}
+	h.ExtendBaseWidget(h)
+	h.Refresh()
+
+	return h
+}
+
+func (h *Heatmap) UpdateData(xLabels, yLabels []string, values [][]float64) {
+	h.xLabels = xLabels
+	h.yLabels = yLabels
+	h.values = values
+	h.tooltip.Hide()
+	h.Refresh()
+}
+
+func (h *Heatmap) SetColorScale(scale ColorScale) {
+	h.scale = scale
+	h.Refresh()
+}
+
+func (h *Heatmap) UpdateHoverFormat(f func(float642 float64) string) {
+	h.hoverFormat = f
+	h.Refresh()
+}
+
+func (h *Heatmap) UpdateOnTouched(f func(row, col int)) {
+	h.onTouched = f
+}
+
+func (h *Heatmap) Tapped(event *fyne.PointEvent) {
+	if row, col, ok := h.cellAt(event.Position); ok && h.onTouched != nil {
+		h.onTouched(row, col)
+	}
+}
+
+func (h *Heatmap) MouseIn(event *desktop.MouseEvent) {
+	h.MouseMoved(event)
+}
+
+func (h *Heatmap) MouseMoved(event *desktop.MouseEvent) {
+	row, col, ok := h.cellAt(event.Position)
+	if !ok {
+		h.MouseOut()
+		return
+	}
+	v, _ := h.value(row, col)
+	text := h.hoverFormat(v)
+	if col < len(h.xLabels) && row < len(h.yLabels) {
+		text = h.yLabels[row] + ", " + h.xLabels[col] + ": " + text
+	}
+	h.tooltip.showAt(text, event.Position)
+	h.canvas.Refresh(h)
+}
+
+func (h *Heatmap) MouseOut() {
+	if h.tooltip.Visible() {
+		h.tooltip.Hide()
+		h.canvas.Refresh(h)
+	}
+}
+
+func (h *Heatmap) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (h *Heatmap) columns() int {
+	return len(h.xLabels)
+}
+
+func (h *Heatmap) rows() int {
+	return len(h.yLabels)
+}
+
+// value is the cell's value and whether it has one, rows may be shorter than the number of columns.
+func (h *Heatmap) value(row, col int) (float64, bool) {
+	if row >= len(h.values) || col >= len(h.values[row]) || math.IsNaN(h.values[row][col]) {
+		return 0, false
+	}
+	return h.values[row][col], true
+}
+
+// cellAt is the cell with a value under pos.
+func (h *Heatmap) cellAt(pos fyne.Position) (int, int, bool) {
+	if h.cellSize.Width <= 0 || h.cellSize.Height <= 0 {
+		return 0, 0, false
+	}
+	rel := pos.Subtract(h.plotPos)
+	if rel.X < 0 || rel.Y < 0 {
+		return 0, 0, false
+	}
+	col, row := int(rel.X/h.cellSize.Width), int(rel.Y/h.cellSize.Height)
+	if col >= h.columns() || row >= h.rows() {
+		return 0, 0, false
+	}
+	if _, ok := h.value(row, col); !ok {
+		return 0, 0, false
+	}
+	return row, col, true
+}
+
+type heatmapRenderer struct {
+	*baseChartRenderer
+	heatmap *Heatmap
+
+	rowLabels []*widget.Label
+
+	// cells is row major and only used for small grids, otherwise raster paints every cell.
+	cells  []*canvas.Rectangle
+	raster *canvas.Raster
+
+	legend       *canvas.Raster
+	legendLabels []*widget.Label
+	legendValues []float64
+	legendLblMax fyne.Size
+
+	// low and high are the domain of the color scale.
+	low, high float64
+}
+
+func (h *heatmapRenderer) Destroy() {
+
+}
+
+func (h *heatmapRenderer) Layout(size fyne.Size) {
+	h.baseChartRenderer.Layout(size)
+
+	xOffset := h.xOffset()
+	top := h.requiredTopHeight()
+	availableHeight := h.availableHeight(size)
+	cellSize := fyne.NewSize(h.columnWidth(size, xOffset), availableHeight/float32(max(h.heatmap.rows(), 1)))
+	h.heatmap.plotPos = fyne.NewPos(xOffset, top)
+	h.heatmap.cellSize = cellSize
+
+	for row, lbl := range h.rowLabels {
+		lblSize := lbl.MinSize()
+		lbl.Move(fyne.NewPos(xOffset-theme.Padding()-lblSize.Width, top+float32(row)*cellSize.Height+cellSize.Height/2-lblSize.Height/2))
+	}
+
+	if h.raster != nil {
+		h.raster.Move(h.heatmap.plotPos)
+		h.raster.Resize(fyne.NewSize(cellSize.Width*float32(h.heatmap.columns()), availableHeight))
+	}
+	cols := h.heatmap.columns()
+	for idx, cell := range h.cells {
+		row, col := idx/cols, idx%cols
+		cell.Move(fyne.NewPos(xOffset+float32(col)*cellSize.Width+heatmapCellGap, top+float32(row)*cellSize.Height+heatmapCellGap))
+		cell.Resize(fyne.NewSize(fyne.Max(cellSize.Width-heatmapCellGap, 0), fyne.Max(cellSize.Height-heatmapCellGap, 0)))
+	}
+
+	legendX := size.Width - h.insets.right + 2*theme.Padding()
+	h.legend.Move(fyne.NewPos(legendX, top))
+	h.legend.Resize(fyne.NewSize(heatmapLegendWidth, availableHeight))
+	for idx, lbl := range h.legendLabels {
+		lblSize := lbl.MinSize()
+		scale := float32(0.5)
+		if h.high > h.low {
+			scale = float32((h.legendValues[idx] - h.low) / (h.high - h.low))
+		}
+		lbl.Move(fyne.NewPos(legendX+heatmapLegendWidth, top+availableHeight*(1-scale)-lblSize.Height/2))
+	}
+
+	h.heatmap.tooltip.Resize(size)
+}
+
+func (h *heatmapRenderer) MinSize() fyne.Size {
+	xCellWidth := fyne.Max(h.xLblMax.Width, defaultHeatmapCellSize) + 2
+	minHeight := fyne.Max(h.heatmap.minHeight, float32(h.heatmap.rows())*h.yLblMax.Height)
+	return fyne.NewSize(h.xOffset()+float32(h.heatmap.columns())*xCellWidth+h.insets.right,
+		h.requiredTopHeight()+h.requiredBottomHeight()+minHeight)
+}
+
+func (h *heatmapRenderer) Objects() []fyne.CanvasObject {
+	cos := h.baseChartRenderer.Objects()
+	for _, lbl := range h.rowLabels {
+		cos = append(cos, lbl)
+	}
+	if h.raster != nil {
+		cos = append(cos, h.raster)
+	}
+	for _, cell := range h.cells {
+		cos = append(cos, cell)
+	}
+	cos = append(cos, h.legend)
+	for _, lbl := range h.legendLabels {
+		cos = append(cos, lbl)
+	}
+	return append(cos, h.heatmap.tooltip)
+}
+
+func (h *heatmapRenderer) Refresh() {
+	// The y-axis is categorical, give the base a valid axis and replace its tick labels with the row labels below.
+	h.yAxis = axis{max: 1, dataRange: 1, normalizer: linearNormalizer{}}
+	h.cells = nil
+	h.raster = nil
+	h.rowLabels = nil
+
+	low, high := math.Inf(1), math.Inf(-1)
+	for row := 0; row < h.heatmap.rows(); row++ {
+		for col := 0; col < h.heatmap.columns(); col++ {
+			if v, ok := h.heatmap.value(row, col); ok {
+				low, high = math.Min(low, v), math.Max(high, v)
+			}
+		}
+	}
+	if math.IsInf(low, 1) {
+		low, high = 0, 0
+	}
+	h.low, h.high = h.heatmap.scale.domain(low, high)
+
+	if h.heatmap.rows()*h.heatmap.columns() > heatmapRasterThreshold {
+		h.raster = canvas.NewRasterWithPixels(h.cellPixel)
+	} else {
+		for row := 0; row < h.heatmap.rows(); row++ {
+			for col := 0; col < h.heatmap.columns(); col++ {
+				h.cells = append(h.cells, canvas.NewRectangle(h.cellColor(row, col)))
+			}
+		}
+	}
+
+	h.refreshLegend()
+	h.baseChartRenderer.Refresh()
+
+	h.yLabels = nil
+	clear(h.yLabelPositions)
+	h.yLblMax = fyne.NewSize(0, 0)
+	for _, name := range h.heatmap.yLabels {
+		lbl := widget.NewLabel(name)
+		h.rowLabels = append(h.rowLabels, lbl)
+		h.yLblMax = h.yLblMax.Max(lbl.MinSize())
+	}
+}
+
+func (h *heatmapRenderer) refreshLegend() {
+	h.legend = canvas.NewRasterWithPixels(func(x, y, w, ht int) color.Color {
+		if ht <= 1 {
+			return h.heatmap.scale.colorAt(h.high, h.low, h.high)
+		}
+		t := 1 - float64(y)/float64(ht-1)
+		return h.heatmap.scale.colorAt(h.low+t*(h.high-h.low), h.low, h.high)
+	})
+
+	h.legendLabels = nil
+	h.legendValues = nil
+	h.legendLblMax = fyne.NewSize(0, 0)
+	if h.high > h.low {
+		ticks, err := orDefaultTicks(h.heatmap.tickGenerator).Ticks(h.low, h.high, h.heatmap.suggestedTickCount)
+		if err != nil {
+			log.Println("error generating legend ticks")
+		}
+		ticks = ticksInRange(ticks, h.low, h.high)
+		for _, tk := range ticks {
+			lbl := widget.NewLabel(h.heatmap.tickFormat(tk))
+			h.legendLabels = append(h.legendLabels, lbl)
+			h.legendValues = append(h.legendValues, tk)
+			h.legendLblMax = h.legendLblMax.Max(lbl.MinSize())
+		}
+	}
+	h.insets.right = 2*theme.Padding() + heatmapLegendWidth + h.legendLblMax.Width
+}
+
+func (h *heatmapRenderer) cellColor(row, col int) color.Color {
+	v, ok := h.heatmap.value(row, col)
+	if !ok {
+		return color.NRGBA{}
+	}
+	return h.heatmap.scale.colorAt(v, h.low, h.high)
+}
+
+// cellPixel paints the raster, which covers every cell.
+func (h *heatmapRenderer) cellPixel(x, y, w, ht int) color.Color {
+	if w <= 0 || ht <= 0 {
+		return color.NRGBA{}
+	}
+	return h.cellColor(y*h.heatmap.rows()/ht, x*h.heatmap.columns()/w)
+}
@@ -0,0 +1,299 @@
+package fynecharts
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"log"
+	"math"
+)
+
+const (
+	histogramBarGap = 2
+	// maxHistogramBins caps the bins a width may produce, wider spans fall back to Sturges' rule.
+	maxHistogramBins = 1000
+)
+
+type BinRule int
+
+const (
+	BinSturges BinRule = iota
+	BinFreedmanDiaconis
+	BinScott
+	// BinFixedWidth uses the width from SetBinWidth, with edges on multiples of it.
+	BinFixedWidth
+	// BinExplicitEdges uses the edges from SetBinEdges.
+	BinExplicitEdges
+)
+
+type HistogramNormalization int
+
+const (
+	HistogramCount HistogramNormalization = iota
+	// HistogramDensity scales the bins so their areas sum to one.
+	HistogramDensity
+	// HistogramCumulative counts every sample up to the end of each bin.
+	HistogramCumulative
+)
+
+type Histogram struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	samples []float64
+
+	rule          BinRule
+	binWidth      float64
+	binEdges      []float64
+	normalization HistogramNormalization
+
+	color       color.Color
+	hoverFormat func(float64) string
+}
+
+func (h *Histogram) CreateRenderer() fyne.WidgetRenderer {
+	bcr := h.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &histogramRenderer{baseChartRenderer: bcr, histogram: h}
+}
+
+func NewHistogram(canvas fyne.Canvas, title string, samples []float64) *Histogram {
+	h := &Histogram{BaseChart: newBaseChart(title, nil, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		samples:     samples,
+		hoverFormat: defaultHoverFormat,
+	}
+	h.ExtendBaseWidget(h)
+	h.Refresh()
+
+	return h
+}
+
+func (h *Histogram) UpdateData(samples []float64) {
+	h.samples = samples
+	h.Refresh()
+}
+
+func (h *Histogram) SetBinRule(rule BinRule) {
+	h.rule = rule
+	h.Refresh()
+}
+
+// SetBinWidth switches to fixed width bins of w.
+func (h *Histogram) SetBinWidth(w float64) {
+	h.rule = BinFixedWidth
+	h.binWidth = w
+	h.Refresh()
+}
+
+// SetBinEdges switches to explicit bins, samples outside of the first and last edge are dropped.
+// The edges must be strictly increasing, otherwise Sturges' rule is used.
+func (h *Histogram) SetBinEdges(edges []float64) {
+	h.rule = BinExplicitEdges
+	h.binEdges = edges
+	h.Refresh()
+}
+
+func (h *Histogram) SetNormalization(normalization HistogramNormalization) {
+	h.normalization = normalization
+	h.Refresh()
+}
+
+// UpdateBarColor sets the bar color, nil uses the theme's primary color.
+func (h *Histogram) UpdateBarColor(c color.Color) {
+	h.color = c
+	h.Refresh()
+}
+
+func (h *Histogram) UpdateHoverFormat(f func(float642 float64) string) {
+	h.hoverFormat = f
+	h.Refresh()
+}
+
+// binText is the hover text of the idx'th bin, which includes its lower edge, the last bin also its upper edge.
+func (h *Histogram) binText(edges []float64, idx int, v float64) string {
+	closing := ")"
+	if idx == len(edges)-2 {
+		closing = "]"
+	}
+	return fmt.Sprintf("[%s, %s%s: %s", h.xTickFormat(edges[idx]), h.xTickFormat(edges[idx+1]), closing, h.hoverFormat(v))
+}
+
+// histogramEdges returns the bin edges for sorted samples, in ascending order with one more edge than bins.
+// Rules that would give more than maxHistogramBins, and edges that are not strictly increasing, use Sturges' rule instead.
+func histogramEdges(sorted []float64, rule BinRule, width float64, edges []float64) []float64 {
+	if rule == BinExplicitEdges {
+		if strictlyIncreasing(edges) {
+			return edges
+		}
+		log.Println("histogram bin edges must be strictly increasing")
+		rule = BinSturges
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return []float64{min - 0.5, min + 0.5}
+	}
+
+	n := float64(len(sorted))
+	switch rule {
+	case BinFixedWidth:
+		if width > 0 && (max-min)/width < maxHistogramBins {
+			start := math.Floor(min/width) * width
+			var fixed []float64
+			for idx := 0; ; idx++ {
+				edge := start + float64(idx)*width
+				fixed = append(fixed, edge)
+				if edge >= max {
+					break
+				}
+			}
+			return fixed
+		}
+	case BinFreedmanDiaconis:
+		width = 2 * (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / math.Cbrt(n)
+	case BinScott:
+		width = 3.49 * stdDev(sorted) / math.Cbrt(n)
+	}
+
+	count := math.Ceil(math.Log2(n)) + 1
+	if rule != BinSturges && width > 0 && (max-min)/width < maxHistogramBins {
+		count = math.Ceil((max - min) / width)
+	}
+	even := make([]float64, int(count)+1)
+	for idx := range even {
+		even[idx] = min + float64(idx)*(max-min)/count
+	}
+	// Rounding may leave the last edge short of the largest sample, which would then be dropped.
+	even[len(even)-1] = max
+	return even
+}
+
+func strictlyIncreasing(edges []float64) bool {
+	if len(edges) < 2 {
+		return false
+	}
+	for idx := 1; idx < len(edges); idx++ {
+		if !(edges[idx] > edges[idx-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// histogramCounts counts the samples in each bin, bins include their lower edge and the last also its upper.
+func histogramCounts(sorted []float64, edges []float64) []float64 {
+	if len(edges) < 2 {
+		return nil
+	}
+	counts := make([]float64, len(edges)-1)
+	bin := 0
+	for _, s := range sorted {
+		if s < edges[0] || s > edges[len(edges)-1] {
+			continue
+		}
+		for bin < len(counts)-1 && s >= edges[bin+1] {
+			bin++
+		}
+		counts[bin]++
+	}
+	return counts
+}
+
+func normalizeHistogram(counts []float64, edges []float64, normalization HistogramNormalization) []float64 {
+	values := make([]float64, len(counts))
+	total := 0.0
+	for _, c := range counts {
+		total += c
+	}
+
+	running := 0.0
+	for idx, c := range counts {
+		switch normalization {
+		case HistogramDensity:
+			if total > 0 {
+				values[idx] = c / total / (edges[idx+1] - edges[idx])
+			}
+		case HistogramCumulative:
+			running += c
+			values[idx] = running
+		default:
+			values[idx] = c
+		}
+	}
+	return values
+}
+
+type histogramRenderer struct {
+	*baseChartRenderer
+	histogram *Histogram
+
+	edges  []float64
+	values []float64
+	bars   []*bar
+}
+
+func (h *histogramRenderer) Destroy() {
+
+}
+
+func (h *histogramRenderer) Layout(size fyne.Size) {
+	h.baseChartRenderer.Layout(size)
+
+	xOffset := h.xOffset()
+
+	availableHeight := h.availableHeight(size)
+	availableWidth := h.availableWidth(size, xOffset)
+
+	reqBottom := h.requiredBottomHeight()
+	for idx, br := range h.bars {
+		left := availableWidth * h.xAxis.normalize(h.edges[idx])
+		right := availableWidth * h.xAxis.normalize(h.edges[idx+1])
+		scale := clipScale(h.yAxis.normalize(h.values[idx]))
+		// A small gap keeps adjacent bars distinguishable.
+		br.Resize(fyne.NewSize(fyne.Max(right-left-histogramBarGap, 1), availableHeight*scale))
+		br.Move(fyne.NewPos(xOffset+left, size.Height-reqBottom-availableHeight*scale))
+	}
+}
+
+func (h *histogramRenderer) MinSize() fyne.Size {
+	xCellWidth := h.xLblMax.Width + 2
+	return fyne.NewSize(h.xOffset()+float32(len(h.xLabels))*xCellWidth,
+		h.requiredTopHeight()+h.requiredBottomHeight()+h.histogram.minHeight)
+}
+
+func (h *histogramRenderer) Objects() []fyne.CanvasObject {
+	cos := h.baseChartRenderer.Objects()
+	for _, br := range h.bars {
+		cos = append(cos, br)
+	}
+	return cos
+}
+
+func (h *histogramRenderer) Refresh() {
+	h.yAxis = axis{normalizer: linearNormalizer{}}
+	h.yAxis.include(0)
+	h.bars = nil
+
+	sorted := sortedCopy(h.histogram.samples)
+	h.edges = histogramEdges(sorted, h.histogram.rule, h.histogram.binWidth, h.histogram.binEdges)
+	counts := histogramCounts(sorted, h.edges)
+	h.values = normalizeHistogram(counts, h.edges, h.histogram.normalization)
+	h.refreshNumericXAxis(h.edges)
+
+	c := h.histogram.color
+	if c == nil {
+		c = theme.PrimaryColor()
+	}
+	for idx, v := range h.values {
+		h.yAxis.include(v)
+		h.bars = append(h.bars, newBar(h.histogram.canvas, h.histogram.binText(h.edges, idx, v), c))
+	}
+	h.yAxis.dataRange = h.yAxis.max - h.yAxis.min
+
+	h.baseChartRenderer.Refresh()
+}
@@ -0,0 +1,108 @@
+package fynecharts
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHistogramSturges(t *testing.T) {
+	samples := make([]float64, 100)
+	for idx := range samples {
+		samples[idx] = float64(idx)
+	}
+	edges := histogramEdges(samples, BinSturges, 0, nil)
+	if len(edges) != 9 {
+		t.Fatal("expected 8 bins, got", len(edges)-1)
+	}
+	counts := histogramCounts(samples, edges)
+	total := 0.0
+	for _, c := range counts {
+		total += c
+	}
+	if total != 100 {
+		t.Error("expected every sample to be counted, got", total)
+	}
+}
+
+func TestHistogramFixedWidth(t *testing.T) {
+	samples := sortedCopy([]float64{0.5, 1, 4.9, 5, 9.5, 10})
+	edges := histogramEdges(samples, BinFixedWidth, 5, nil)
+	expected := []float64{0, 5, 10}
+	if len(edges) != len(expected) {
+		t.Fatal("unexpected edges", edges)
+	}
+	counts := histogramCounts(samples, edges)
+	if counts[0] != 3 || counts[1] != 3 {
+		t.Error("unexpected counts", counts)
+	}
+}
+
+func TestHistogramNormalization(t *testing.T) {
+	samples := sortedCopy([]float64{1, 2, 2, 3, 7, 8, 9, 20})
+	edges := []float64{0, 5, 10, 15}
+	counts := histogramCounts(samples, edges)
+	if counts[0] != 4 || counts[1] != 3 || counts[2] != 0 {
+		t.Error("unexpected counts, samples beyond the last edge should be dropped", counts)
+	}
+
+	density := normalizeHistogram(counts, edges, HistogramDensity)
+	area := 0.0
+	for idx, d := range density {
+		area += d * (edges[idx+1] - edges[idx])
+	}
+	if math.Abs(area-1) > 1e-9 {
+		t.Error("expected density to integrate to one, got", area)
+	}
+
+	cumulative := normalizeHistogram(counts, edges, HistogramCumulative)
+	if cumulative[len(cumulative)-1] != 7 {
+		t.Error("expected the last cumulative bin to hold every counted sample", cumulative)
+	}
+}
+
+func TestHistogramBinCountIsCapped(t *testing.T) {
+	samples := make([]float64, 100)
+	for idx := range samples {
+		samples[idx] = float64(idx) / 100
+	}
+	samples = append(samples, 1e9)
+	for _, rule := range []BinRule{BinFixedWidth, BinFreedmanDiaconis} {
+		edges := histogramEdges(samples, rule, 0.01, nil)
+		if len(edges) > maxHistogramBins+1 {
+			t.Error("expected the bins to be capped, got", len(edges)-1)
+		}
+	}
+}
+
+func TestHistogramRejectsUnorderedEdges(t *testing.T) {
+	samples := sortedCopy([]float64{1, 2, 3, 4})
+	for _, edges := range [][]float64{{0, 5, 5, 10}, {10, 5, 0}, {3}} {
+		got := histogramEdges(samples, BinExplicitEdges, 0, edges)
+		if !strictlyIncreasing(got) {
+			t.Error("expected strictly increasing edges for", edges, "got", got)
+		}
+	}
+}
+
+func TestHistogramCountsTheLargestSample(t *testing.T) {
+	samples := sortedCopy([]float64{1.3, 2, 2.2, 3.1, 3.3, 3.9, 4.4, 5.7, 6.1, 7.9})
+	edges := histogramEdges(samples, BinSturges, 0, nil)
+	counts := histogramCounts(samples, edges)
+	if counts[len(counts)-1] != 1 {
+		t.Error("expected the largest sample in the last bin", edges, counts)
+	}
+}
+
+func TestHistogramBinTextClosesTheLastBin(t *testing.T) {
+	h := NewHistogram(nil, "", nil)
+	h.UpdateHoverFormat(func(v float64) string { return fmt.Sprint(v) })
+	h.UpdateXTickFormat(func(v float64) string { return fmt.Sprint(v) })
+	edges := []float64{0, 5, 10}
+	if text := h.binText(edges, 0, 3); text != "[0, 5): 3" {
+		t.Error("expected the first bin to be half open", text)
+	}
+	if text := h.binText(edges, 1, 2); text != "[5, 10]: 2" {
+		t.Error("expected the last bin to be closed", text)
+	}
+}
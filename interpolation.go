@@ -0,0 +1,121 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"math"
+)
+
+// monotoneSegments is how many straight segments approximate the curve between two points,
+// fixed so the number of lines a series needs is known before layout.
+const monotoneSegments = 12
+
+// Interpolation is how a line gets from one point to the next.
+type Interpolation int
+
+const (
+	InterpolationLinear Interpolation = iota
+	// InterpolationStepBefore jumps to each value at the start of the interval leading up to it.
+	InterpolationStepBefore
+	// InterpolationStepAfter holds each value until the next point, for levels such as setpoints.
+	InterpolationStepAfter
+	// InterpolationStepMiddle changes value halfway between points.
+	InterpolationStepMiddle
+	// InterpolationMonotone is a smooth curve that never overshoots the points, so it only rises or falls where the data does.
+	InterpolationMonotone
+)
+
+// interpolatedLength is the number of points interpolate returns for n points.
+func interpolatedLength(n int, mode Interpolation) int {
+	if n < 2 {
+		return n
+	}
+	switch mode {
+	case InterpolationStepBefore, InterpolationStepAfter:
+		return 2*n - 1
+	case InterpolationStepMiddle:
+		return 3*n - 2
+	case InterpolationMonotone:
+		return (n-1)*monotoneSegments + 1
+	default:
+		return n
+	}
+}
+
+// interpolate returns the path through points for mode, which expects x to increase from point to point.
+func interpolate(points []fyne.Position, mode Interpolation) []fyne.Position {
+	if len(points) < 2 {
+		return points
+	}
+
+	path := make([]fyne.Position, 0, interpolatedLength(len(points), mode))
+	path = append(path, points[0])
+	switch mode {
+	case InterpolationStepBefore:
+		for idx := 1; idx < len(points); idx++ {
+			path = append(path, fyne.NewPos(points[idx-1].X, points[idx].Y), points[idx])
+		}
+	case InterpolationStepAfter:
+		for idx := 1; idx < len(points); idx++ {
+			path = append(path, fyne.NewPos(points[idx].X, points[idx-1].Y), points[idx])
+		}
+	case InterpolationStepMiddle:
+		for idx := 1; idx < len(points); idx++ {
+			mid := (points[idx-1].X + points[idx].X) / 2
+			path = append(path, fyne.NewPos(mid, points[idx-1].Y), fyne.NewPos(mid, points[idx].Y), points[idx])
+		}
+	case InterpolationMonotone:
+		tangents := monotoneTangents(points)
+		for idx := 1; idx < len(points); idx++ {
+			a, b := points[idx-1], points[idx]
+			h := float64(b.X - a.X)
+			for seg := 1; seg <= monotoneSegments; seg++ {
+				t := float64(seg) / monotoneSegments
+				// Cubic Hermite basis.
+				h00 := 2*t*t*t - 3*t*t + 1
+				h10 := t*t*t - 2*t*t + t
+				h01 := -2*t*t*t + 3*t*t
+				h11 := t*t*t - t*t
+				y := h00*float64(a.Y) + h10*h*tangents[idx-1] + h01*float64(b.Y) + h11*h*tangents[idx]
+				path = append(path, fyne.NewPos(a.X+float32(t*h), float32(y)))
+			}
+		}
+	default:
+		path = append(path, points[1:]...)
+	}
+	return path
+}
+
+// monotoneTangents returns the slope at each point using the Fritsch–Carlson method, which limits the
+// tangents so the Hermite curve between points stays monotone.
+func monotoneTangents(points []fyne.Position) []float64 {
+	n := len(points)
+	secants := make([]float64, n-1)
+	for idx := range secants {
+		dx := float64(points[idx+1].X - points[idx].X)
+		if dx != 0 {
+			secants[idx] = float64(points[idx+1].Y-points[idx].Y) / dx
+		}
+	}
+
+	tangents := make([]float64, n)
+	tangents[0], tangents[n-1] = secants[0], secants[n-2]
+	for idx := 1; idx < n-1; idx++ {
+		if secants[idx-1]*secants[idx] > 0 {
+			tangents[idx] = (secants[idx-1] + secants[idx]) / 2
+		}
+	}
+
+	for idx, secant := range secants {
+		if secant == 0 {
+			tangents[idx], tangents[idx+1] = 0, 0
+			continue
+		}
+		alpha, beta := tangents[idx]/secant, tangents[idx+1]/secant
+		if s := alpha*alpha + beta*beta; s > 9 {
+			tau := 3 / math.Sqrt(s)
+			tangents[idx] = tau * alpha * secant
+			tangents[idx+1] = tau * beta * secant
+		}
+	}
+	return tangents
+}
@@ -0,0 +1,38 @@
+package fynecharts
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestInterpolateLengths(t *testing.T) {
+	points := []fyne.Position{{X: 0, Y: 0}, {X: 10, Y: 5}, {X: 20, Y: 5}, {X: 30, Y: 0}}
+	for _, mode := range []Interpolation{InterpolationLinear, InterpolationStepBefore, InterpolationStepAfter, InterpolationStepMiddle, InterpolationMonotone} {
+		if path := interpolate(points, mode); len(path) != interpolatedLength(len(points), mode) {
+			t.Error("unexpected path length", mode, len(path))
+		}
+	}
+}
+
+func TestInterpolateStepAfter(t *testing.T) {
+	path := interpolate([]fyne.Position{{X: 0, Y: 0}, {X: 10, Y: 5}}, InterpolationStepAfter)
+	if path[1] != fyne.NewPos(10, 0) {
+		t.Error("expected the value to hold until the next point", path)
+	}
+}
+
+func TestInterpolateMonotoneDoesNotOvershoot(t *testing.T) {
+	points := []fyne.Position{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 20, Y: 10}, {X: 30, Y: 40}}
+	path := interpolate(points, InterpolationMonotone)
+	for idx := 1; idx < len(path); idx++ {
+		if path[idx].Y < path[idx-1].Y {
+			t.Error("expected the curve to never fall", idx, path[idx-1], path[idx])
+		}
+	}
+	for _, pt := range path[monotoneSegments : 2*monotoneSegments+1] {
+		if pt.Y != 10 {
+			t.Error("expected the flat interval to stay flat", pt)
+		}
+	}
+}
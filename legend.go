@@ -0,0 +1,238 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image/color"
+)
+
+const (
+	legendSwatchWidth = 24
+	legendMarkerSize  = 8
+)
+
+type LegendPosition int
+
+const (
+	LegendTop LegendPosition = iota
+	LegendBottom
+	LegendLeft
+	LegendRight
+	// LegendInside draws the legend over the top right corner of the plot, taking no room from it.
+	LegendInside
+	LegendHidden
+)
+
+// legendEntry is a series' line and marker next to its name, tapping it toggles the series.
+type legendEntry struct {
+	widget.BaseWidget
+
+	name   string
+	color  color.Color
+	marker MarkerShape
+	style  LineStyle
+	hidden bool
+
+	onTapped func()
+}
+
+func newLegendEntry(name string, c color.Color, marker MarkerShape, style LineStyle, hidden bool, onTapped func()) *legendEntry {
+	l := &legendEntry{name: name, color: c, marker: marker, style: style, hidden: hidden, onTapped: onTapped}
+	l.ExtendBaseWidget(l)
+
+	return l
+}
+
+func (l *legendEntry) Tapped(event *fyne.PointEvent) {
+	if l.onTapped != nil {
+		l.onTapped()
+	}
+}
+
+func (l *legendEntry) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+// drawColor is the series color, or the disabled color while the series is hidden.
+func (l *legendEntry) drawColor() color.Color {
+	if l.hidden {
+		return theme.DisabledColor()
+	}
+	return l.color
+}
+
+func (l *legendEntry) CreateRenderer() fyne.WidgetRenderer {
+	c := l.drawColor()
+	return &legendEntryRenderer{
+		l:      l,
+		line:   newPolyline(c, 2, l.style),
+		marker: newMarker(l.marker, c),
+		text:   canvas.NewText(l.name, theme.ForegroundColor()),
+	}
+}
+
+type legendEntryRenderer struct {
+	l *legendEntry
+
+	line   *polyline
+	marker []fyne.CanvasObject
+	text   *canvas.Text
+}
+
+func (l *legendEntryRenderer) Destroy() {
+
+}
+
+func (l *legendEntryRenderer) Layout(size fyne.Size) {
+	middle := size.Height / 2
+	l.line.setPoints([]fyne.Position{fyne.NewPos(0, middle), fyne.NewPos(legendSwatchWidth, middle)})
+	markerPos := fyne.NewPos(legendSwatchWidth/2-legendMarkerSize/2, middle-legendMarkerSize/2)
+	layoutMarker(l.l.marker, l.marker, markerPos, fyne.NewSize(legendMarkerSize, legendMarkerSize))
+
+	textSize := l.text.MinSize()
+	l.text.Move(fyne.NewPos(legendSwatchWidth+theme.Padding(), middle-textSize.Height/2))
+	l.text.Resize(textSize)
+}
+
+func (l *legendEntryRenderer) MinSize() fyne.Size {
+	textSize := l.text.MinSize()
+	return fyne.NewSize(legendSwatchWidth+theme.Padding()+textSize.Width, fyne.Max(textSize.Height, legendMarkerSize))
+}
+
+func (l *legendEntryRenderer) Objects() []fyne.CanvasObject {
+	return append([]fyne.CanvasObject{l.line.Raster, l.text}, l.marker...)
+}
+
+func (l *legendEntryRenderer) Refresh() {
+	c := l.l.drawColor()
+	l.line.stroke = color.NRGBAModel.Convert(c).(color.NRGBA)
+	l.line.style = l.l.style
+	l.marker = newMarker(l.l.marker, c)
+	l.text.Text = l.l.name
+	l.text.Color = theme.ForegroundColor()
+	if l.l.hidden {
+		l.text.Color = theme.DisabledColor()
+	}
+	l.Layout(l.l.Size())
+	l.line.Refresh()
+	l.text.Refresh()
+}
+
+// legend lays out the entries of a chart's named series around or inside its plot.
+type legend struct {
+	position   LegendPosition
+	entries    []*legendEntry
+	background *canvas.Rectangle
+}
+
+func newLegend(position LegendPosition, entries []*legendEntry) *legend {
+	return &legend{position: position, entries: entries, background: canvas.NewRectangle(theme.OverlayBackgroundColor())}
+}
+
+func (l *legend) visible() bool {
+	return l.position != LegendHidden && len(l.entries) > 0
+}
+
+// rowSize is the size of the entries side by side, columnSize of them stacked.
+func (l *legend) rowSize() fyne.Size {
+	size := fyne.NewSize(0, 0)
+	for idx, entry := range l.entries {
+		entrySize := entry.MinSize()
+		size.Width += entrySize.Width
+		if idx > 0 {
+			size.Width += 2 * theme.Padding()
+		}
+		size.Height = fyne.Max(size.Height, entrySize.Height)
+	}
+	return size
+}
+
+func (l *legend) columnSize() fyne.Size {
+	size := fyne.NewSize(0, 0)
+	for _, entry := range l.entries {
+		entrySize := entry.MinSize()
+		size.Width = fyne.Max(size.Width, entrySize.Width)
+		size.Height += entrySize.Height + theme.Padding()
+	}
+	return size
+}
+
+// reserve is the room the legend needs beside the plot.
+func (l *legend) reserve() insets {
+	if !l.visible() {
+		return insets{}
+	}
+	switch l.position {
+	case LegendTop:
+		return insets{top: l.rowSize().Height + theme.Padding()}
+	case LegendBottom:
+		return insets{bottom: l.rowSize().Height + theme.Padding()}
+	case LegendLeft:
+		return insets{left: l.columnSize().Width + 2*theme.Padding()}
+	case LegendRight:
+		return insets{right: l.columnSize().Width + 2*theme.Padding()}
+	}
+	return insets{}
+}
+
+// layout places the entries in the room reserved by b, or over the top right of its plot when inside.
+func (l *legend) layout(b *baseChartRenderer, size fyne.Size) {
+	l.background.Hide()
+	if !l.visible() {
+		return
+	}
+
+	top := b.requiredTopHeight()
+	switch l.position {
+	case LegendTop, LegendBottom:
+		row := l.rowSize()
+		y := top - b.insets.top
+		if l.position == LegendBottom {
+			y = size.Height - b.insets.bottom
+		}
+		l.layoutRow(fyne.NewPos(size.Width/2-row.Width/2, y), row.Height)
+	case LegendLeft:
+		l.layoutColumn(fyne.NewPos(theme.Padding(), top))
+	case LegendRight:
+		l.layoutColumn(fyne.NewPos(size.Width-b.insets.right+theme.Padding(), top))
+	case LegendInside:
+		column := l.columnSize()
+		pos := fyne.NewPos(b.plotRight(size)-theme.Padding()-column.Width, top+theme.Padding())
+		l.background.Move(pos.SubtractXY(theme.Padding(), theme.Padding()/2))
+		l.background.Resize(column.AddWidthHeight(2*theme.Padding(), 0))
+		l.background.Show()
+		l.layoutColumn(pos)
+	}
+}
+
+func (l *legend) layoutRow(pos fyne.Position, height float32) {
+	for _, entry := range l.entries {
+		entrySize := entry.MinSize()
+		entry.Move(pos.AddXY(0, height/2-entrySize.Height/2))
+		entry.Resize(entrySize)
+		pos.X += entrySize.Width + 2*theme.Padding()
+	}
+}
+
+func (l *legend) layoutColumn(pos fyne.Position) {
+	for _, entry := range l.entries {
+		entrySize := entry.MinSize()
+		entry.Move(pos)
+		entry.Resize(entrySize)
+		pos.Y += entrySize.Height + theme.Padding()
+	}
+}
+
+func (l *legend) objects() []fyne.CanvasObject {
+	if !l.visible() {
+		return nil
+	}
+	cos := []fyne.CanvasObject{l.background}
+	for _, entry := range l.entries {
+		cos = append(cos, entry)
+	}
+	return cos
+}
@@ -0,0 +1,152 @@
+package fynecharts
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// LineChart plots y against a numeric x, connecting the points in the order they are given.
+type LineChart struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	xData []float64
+	yData []float64
+
+	dotDiameter float32
+
+	hoverFormat func(x, y float64) string
+}
+
+func (l *LineChart) CreateRenderer() fyne.WidgetRenderer {
+	bcr := l.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &lineChartRenderer{
+		baseChartRenderer: bcr,
+		lineChart:         l,
+	}
+}
+
+func NewLineChart(canvas fyne.Canvas, title string, x, y []float64) *LineChart {
+	lc := &LineChart{BaseChart: newBaseChart(title, nil, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		xData:       x,
+		yData:       y,
+		dotDiameter: defaultDotDiameter,
+		hoverFormat: defaultXYHoverFormat,
+	}
+	lc.ExtendBaseWidget(lc)
+	lc.Refresh()
+
+	return lc
+}
+
+func (l *LineChart) UpdateData(x, y []float64) {
+	l.xData = x
+	l.yData = y
+	l.Refresh()
+}
+
+func (l *LineChart) UpdateHoverFormat(f func(x, y float64) string) {
+	l.hoverFormat = f
+	l.Refresh()
+}
+
+func (l *LineChart) UpdateDotDiameter(diameter float32) {
+	l.dotDiameter = diameter
+	l.Refresh()
+}
+
+func defaultXYHoverFormat(x, y float64) string {
+	return fmt.Sprintf("(%s, %s)", defaultHoverFormat(x), defaultHoverFormat(y))
+}
+
+type lineChartRenderer struct {
+	*baseChartRenderer
+	lineChart *LineChart
+
+	data         []*dot
+	connectLines []*canvas.Line
+}
+
+func (l *lineChartRenderer) Destroy() {
+
+}
+
+func (l *lineChartRenderer) Layout(size fyne.Size) {
+	l.baseChartRenderer.Layout(size)
+
+	xOffset := l.xOffset()
+
+	availableHeight := l.availableHeight(size)
+	availableWidth := l.availableWidth(size, xOffset)
+
+	reqBottom := l.requiredBottomHeight()
+	plotTop, plotBottom := size.Height-reqBottom-availableHeight, size.Height-reqBottom
+	diameter := l.lineChart.dotDiameter
+	var previous fyne.Position
+	for idx, dt := range l.data {
+		dt.Resize(fyne.NewSize(diameter, diameter))
+		center := fyne.NewPos(xOffset+availableWidth*l.xAxis.normalize(l.lineChart.xData[idx]),
+			size.Height-reqBottom-availableHeight*l.yAxis.normalize(l.lineChart.yData[idx]))
+		if idx > 0 {
+			ln := l.connectLines[idx-1]
+			var ok bool
+			ln.Position1, ln.Position2, ok = clipSegment(previous, center, plotTop, plotBottom)
+			if ok {
+				ln.Show()
+			} else {
+				ln.Hide()
+			}
+		}
+		previous = center
+		dt.Move(center.SubtractXY(diameter/2, diameter/2))
+		// Points beyond a fixed range are left off the plot, their lines are cut at its edge.
+		if l.yAxis.contains(l.lineChart.yData[idx]) {
+			dt.Show()
+		} else {
+			dt.Hide()
+		}
+	}
+}
+
+func (l *lineChartRenderer) MinSize() fyne.Size {
+	xCellWidth := l.xLblMax.Width + 2
+	return fyne.NewSize(l.xOffset()+float32(len(l.xLabels))*xCellWidth,
+		l.requiredTopHeight()+l.requiredBottomHeight()+l.lineChart.minHeight)
+}
+
+func (l *lineChartRenderer) Objects() []fyne.CanvasObject {
+	cos := l.baseChartRenderer.Objects()
+	for _, ln := range l.connectLines {
+		cos = append(cos, ln)
+	}
+	for _, d := range l.data {
+		cos = append(cos, d)
+	}
+	return cos
+}
+
+func (l *lineChartRenderer) Refresh() {
+	l.yAxis = axis{normalizer: linearNormalizer{}}
+	l.connectLines = nil
+	l.data = nil
+
+	count := min(len(l.lineChart.xData), len(l.lineChart.yData))
+	l.refreshNumericXAxis(l.lineChart.xData[:count])
+	for idx, y := range l.lineChart.yData[:count] {
+		l.yAxis.include(y)
+		l.data = append(l.data, newDot(l.lineChart.canvas, l.lineChart.hoverFormat(l.lineChart.xData[idx], y), MarkerCircle, theme.PrimaryColor()))
+
+		if idx > 0 {
+			ln := canvas.NewLine(theme.PrimaryColor())
+			ln.StrokeWidth = 2
+			l.connectLines = append(l.connectLines, ln)
+		}
+	}
+	l.yAxis.dataRange = l.yAxis.max - l.yAxis.min
+
+	l.baseChartRenderer.Refresh()
+}
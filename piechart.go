@@ -0,0 +1,405 @@
+package fynecharts
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+const (
+	defaultPieMinRadius = 50
+	// smallSliceFraction is the share of the whole below which a slice label moves outside with a leader line.
+	smallSliceFraction = 0.08
+	pieLeaderLength    = 12
+	pieExplode         = 8
+	// pieMaxInnerRadius keeps a ring of the donut to draw and hover however large the hole is asked to be.
+	pieMaxInnerRadius = 0.95
+)
+
+type PieChart struct {
+	widget.BaseWidget
+	canvas fyne.Canvas
+
+	title  string
+	labels []string
+	data   []float64
+	colors []color.Color
+
+	innerRadius float32
+
+	hoverFormat func(float64) string
+	onTouched   func(idx int)
+
+	hovered int
+	tooltip *tooltip
+
+	// center and radius are kept up to date by the renderer so pointer events can be mapped to slices.
+	center fyne.Position
+	radius float32
+}
+
+func NewPieChart(canvas fyne.Canvas, title string, labels []string, data []float64) *PieChart {
+	pc := &PieChart{canvas: canvas, title: title, labels: labels, data: data, hoverFormat: defaultHoverFormat, hovered: -1, tooltip: newTooltip()}
+	pc.ExtendBaseWidget(pc)
+	pc.Refresh()
+
+	return pc
+}
+
+func (p *PieChart) CreateRenderer() fyne.WidgetRenderer {
+	titleLbl := canvas.NewText(p.title, theme.ForegroundColor())
+	titleLbl.TextSize = theme.TextSize() + 6
+	titleLbl.Hide()
+
+	pr := &pieChartRenderer{
+		pieChart: p,
+		titleLbl: titleLbl,
+	}
+	pr.slices = canvas.NewRaster(pr.generate)
+	return pr
+}
+
+func (p *PieChart) UpdateData(labels []string, data []float64) {
+	p.labels = labels
+	p.data = data
+	p.hovered = -1
+	p.tooltip.Hide()
+	p.Refresh()
+}
+
+// UpdateColors sets the slice colors in order, slices without a color use the default series colors.
+func (p *PieChart) UpdateColors(colors []color.Color) {
+	p.colors = colors
+	p.Refresh()
+}
+
+// SetInnerRadius turns the pie into a donut, ratio is the size of the hole relative to the pie from 0 to 1.
+// Values outside that range are clamped, and the hole always leaves a thin ring of the pie to draw.
+func (p *PieChart) SetInnerRadius(ratio float32) {
+	p.innerRadius = max(0, min(ratio, pieMaxInnerRadius))
+	p.Refresh()
+}
+
+func (p *PieChart) UpdateHoverFormat(f func(float642 float64) string) {
+	p.hoverFormat = f
+}
+
+func (p *PieChart) UpdateOnTouched(f func(idx int)) {
+	p.onTouched = f
+}
+
+func (p *PieChart) Tapped(event *fyne.PointEvent) {
+	if idx := p.sliceAt(event.Position); idx >= 0 && p.onTouched != nil {
+		p.onTouched(idx)
+	}
+}
+
+func (p *PieChart) MouseIn(event *desktop.MouseEvent) {
+	p.MouseMoved(event)
+}
+
+// MouseMoved only redraws the pie when the pointer crosses into another slice, within a slice the tooltip just follows it.
+func (p *PieChart) MouseMoved(event *desktop.MouseEvent) {
+	hovered := p.sliceAt(event.Position)
+	if hovered < 0 {
+		p.MouseOut()
+		return
+	}
+	p.tooltip.showAt(p.hoverText(hovered), event.Position)
+	if hovered != p.hovered {
+		p.hovered = hovered
+		p.Refresh()
+		p.canvas.Refresh(p)
+	}
+}
+
+func (p *PieChart) MouseOut() {
+	p.tooltip.Hide()
+	if p.hovered >= 0 {
+		p.hovered = -1
+		p.Refresh()
+		p.canvas.Refresh(p)
+	}
+}
+
+func (p *PieChart) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (p *PieChart) total() float64 {
+	total := 0.0
+	for _, d := range p.data {
+		total += math.Max(d, 0)
+	}
+	return total
+}
+
+func (p *PieChart) hoverText(idx int) string {
+	d := p.data[idx]
+	text := fmt.Sprintf("%s (%.1f%%)", p.hoverFormat(d), math.Max(d, 0)/p.total()*100)
+	if name := p.sliceLabel(idx); name != "" {
+		text = name + ": " + text
+	}
+	return text
+}
+
+func (p *PieChart) sliceColor(idx int) color.Color {
+	var c color.Color
+	if idx < len(p.colors) {
+		c = p.colors[idx]
+	}
+	return defaultSeriesColor(idx, c)
+}
+
+func (p *PieChart) sliceLabel(idx int) string {
+	if idx < len(p.labels) {
+		return p.labels[idx]
+	}
+	return ""
+}
+
+// sliceBoundaries returns the angle each slice starts at, in radians clockwise from twelve o'clock,
+// followed by the end of the last slice, so slice idx covers boundaries[idx] to boundaries[idx+1].
+func (p *PieChart) sliceBoundaries() []float64 {
+	boundaries := make([]float64, len(p.data)+1)
+	total := p.total()
+	if total == 0 {
+		return boundaries
+	}
+	for idx, d := range p.data {
+		boundaries[idx+1] = boundaries[idx] + math.Max(d, 0)/total*2*math.Pi
+	}
+	return boundaries
+}
+
+// sliceAt returns the slice under pos, or -1 when pos is off the pie or in the donut's hole.
+func (p *PieChart) sliceAt(pos fyne.Position) int {
+	angle, distance := polarFrom(p.center, pos.X, pos.Y)
+	if distance > float64(p.radius) || distance < float64(p.radius*p.innerRadius) {
+		return -1
+	}
+	return sliceContaining(p.sliceBoundaries(), angle)
+}
+
+func sliceContaining(boundaries []float64, angle float64) int {
+	for idx := 0; idx+1 < len(boundaries); idx++ {
+		if angle >= boundaries[idx] && angle < boundaries[idx+1] {
+			return idx
+		}
+	}
+	return -1
+}
+
+// polarFrom returns the clockwise angle from twelve o'clock and the distance of x, y from center.
+func polarFrom(center fyne.Position, x, y float32) (float64, float64) {
+	dx, dy := float64(x-center.X), float64(y-center.Y)
+	angle := math.Atan2(dx, -dy)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+	return angle, math.Hypot(dx, dy)
+}
+
+// pointAt returns the position at angle, clockwise from twelve o'clock, and distance from center.
+func pointAt(center fyne.Position, angle float64, distance float32) fyne.Position {
+	return center.AddXY(distance*float32(math.Sin(angle)), -distance*float32(math.Cos(angle)))
+}
+
+type pieChartRenderer struct {
+	pieChart *PieChart
+
+	titleLbl    *canvas.Text
+	slices      *canvas.Raster
+	sliceLabels []*canvas.Text
+	leaderLines []*canvas.Line
+}
+
+func (p *pieChartRenderer) Destroy() {
+
+}
+
+func (p *pieChartRenderer) Layout(size fyne.Size) {
+	titleSize := visibleTextSize(p.titleLbl)
+	p.titleLbl.Move(fyne.NewPos(size.Width/2-titleSize.Width/2, theme.Padding()))
+	p.titleLbl.Resize(titleSize)
+
+	top := titleSize.Height + 2*theme.Padding()
+	outside := p.outsideLabelSize()
+	available := fyne.NewSize(size.Width-2*outside.Width-2*theme.Padding(), size.Height-top-2*outside.Height-theme.Padding())
+	radius := fyne.Max(fyne.Min(available.Width, available.Height)/2-pieExplode, 0)
+	center := fyne.NewPos(size.Width/2, top+(size.Height-top)/2)
+	p.pieChart.center = center
+	p.pieChart.radius = radius
+
+	p.slices.Move(fyne.NewPos(0, 0))
+	p.slices.Resize(size)
+
+	midRadius := radius * (1 + p.pieChart.innerRadius) / 2
+	boundaries := p.pieChart.sliceBoundaries()
+	var left, right []int
+	for idx, lbl := range p.sliceLabels {
+		mid := (boundaries[idx] + boundaries[idx+1]) / 2
+		lblSize := lbl.MinSize()
+		leader := p.leaderLines[idx]
+		if leader.Hidden {
+			lbl.Move(pointAt(center, mid, midRadius).SubtractXY(lblSize.Width/2, lblSize.Height/2))
+			continue
+		}
+
+		leader.Position1 = pointAt(center, mid, radius)
+		leader.Position2 = pointAt(center, mid, radius+pieExplode+pieLeaderLength)
+		if math.Sin(mid) < 0 {
+			left = append(left, idx)
+		} else {
+			right = append(right, idx)
+		}
+	}
+	p.layoutOutsideLabels(left, true)
+	p.layoutOutsideLabels(right, false)
+
+	p.pieChart.tooltip.Resize(size)
+}
+
+// layoutOutsideLabels places the labels of small slices on one side of the pie beside the end of their
+// leader lines, pushing them down where they would overlap the label above.
+func (p *pieChartRenderer) layoutOutsideLabels(indexes []int, leftSide bool) {
+	sort.Slice(indexes, func(i, j int) bool {
+		return p.leaderLines[indexes[i]].Position2.Y < p.leaderLines[indexes[j]].Position2.Y
+	})
+
+	nextTop := float32(math.Inf(-1))
+	for _, idx := range indexes {
+		lbl, leader := p.sliceLabels[idx], p.leaderLines[idx]
+		lblSize := lbl.MinSize()
+		top := fyne.Max(leader.Position2.Y-lblSize.Height/2, nextTop)
+		leader.Position2.Y = top + lblSize.Height/2
+		nextTop = top + lblSize.Height
+
+		x := leader.Position2.X + theme.Padding()/2
+		if leftSide {
+			x = leader.Position2.X - lblSize.Width - theme.Padding()/2
+		}
+		lbl.Move(fyne.NewPos(x, top))
+	}
+}
+
+// outsideLabelSize is the room needed around the pie for labels of small slices and their leader lines.
+func (p *pieChartRenderer) outsideLabelSize() fyne.Size {
+	outside := fyne.NewSize(0, 0)
+	for idx, lbl := range p.sliceLabels {
+		if !p.leaderLines[idx].Hidden {
+			outside = outside.Max(lbl.MinSize())
+		}
+	}
+	if outside.IsZero() {
+		return outside
+	}
+	return outside.AddWidthHeight(pieLeaderLength+theme.Padding(), pieLeaderLength)
+}
+
+func (p *pieChartRenderer) MinSize() fyne.Size {
+	titleSize := visibleTextSize(p.titleLbl)
+	outside := p.outsideLabelSize()
+	diameter := float32(2 * (defaultPieMinRadius + pieExplode))
+	return fyne.NewSize(fyne.Max(titleSize.Width, diameter+2*outside.Width)+2*theme.Padding(),
+		titleSize.Height+diameter+2*outside.Height+3*theme.Padding())
+}
+
+func (p *pieChartRenderer) Objects() []fyne.CanvasObject {
+	cos := []fyne.CanvasObject{p.titleLbl, p.slices}
+	for _, l := range p.leaderLines {
+		cos = append(cos, l)
+	}
+	for _, lbl := range p.sliceLabels {
+		cos = append(cos, lbl)
+	}
+	return append(cos, p.pieChart.tooltip)
+}
+
+func (p *pieChartRenderer) Refresh() {
+	if p.pieChart.title != "" {
+		p.titleLbl.Text = p.pieChart.title
+		p.titleLbl.Refresh()
+		p.titleLbl.Show()
+	} else {
+		p.titleLbl.Hide()
+	}
+
+	p.sliceLabels = nil
+	p.leaderLines = nil
+	total := p.pieChart.total()
+	for idx, d := range p.pieChart.data {
+		fraction := 0.0
+		if total > 0 {
+			fraction = math.Max(d, 0) / total
+		}
+		text := fmt.Sprintf("%.1f%%", fraction*100)
+		if name := p.pieChart.sliceLabel(idx); name != "" {
+			text = name + " " + text
+		}
+		lbl := canvas.NewText(text, theme.ForegroundColor())
+		if fraction == 0 {
+			lbl.Hide()
+		}
+		leader := canvas.NewLine(theme.ForegroundColor())
+		if fraction >= smallSliceFraction || fraction == 0 {
+			leader.Hide()
+		}
+		p.sliceLabels = append(p.sliceLabels, lbl)
+		p.leaderLines = append(p.leaderLines, leader)
+	}
+
+	p.slices.Refresh()
+}
+
+// generate paints every slice, pulling the hovered slice out from the center along its middle angle.
+func (p *pieChartRenderer) generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	size := p.slices.Size()
+	chart := p.pieChart
+	if size.Width <= 0 || size.Height <= 0 || chart.radius <= 0 || chart.total() == 0 {
+		return img
+	}
+
+	colors := make([]color.NRGBA, len(chart.data))
+	for idx := range chart.data {
+		colors[idx] = color.NRGBAModel.Convert(chart.sliceColor(idx)).(color.NRGBA)
+	}
+	boundaries := chart.sliceBoundaries()
+	hovered := chart.hovered
+	explodedCenter := chart.center
+	if hovered >= 0 {
+		explodedCenter = pointAt(chart.center, (boundaries[hovered]+boundaries[hovered+1])/2, pieExplode)
+	}
+
+	scaleX, scaleY := size.Width/float32(w), size.Height/float32(h)
+	inner := float64(chart.radius * chart.innerRadius)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			px, py := (float32(x)+0.5)*scaleX, (float32(y)+0.5)*scaleY
+			if hovered >= 0 {
+				angle, distance := polarFrom(explodedCenter, px, py)
+				if distance <= float64(chart.radius) && distance >= inner && sliceContaining(boundaries, angle) == hovered {
+					img.SetNRGBA(x, y, colors[hovered])
+					continue
+				}
+			}
+
+			angle, distance := polarFrom(chart.center, px, py)
+			if distance > float64(chart.radius) || distance < inner {
+				continue
+			}
+			if idx := sliceContaining(boundaries, angle); idx >= 0 && idx != hovered {
+				img.SetNRGBA(x, y, colors[idx])
+			}
+		}
+	}
+	return img
+}
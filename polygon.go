@@ -0,0 +1,88 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+// polygon is a filled polygon drawn into a raster covering its bounding box, fyne has no polygon primitive.
+// Painters only recognise the raster itself, so renderers must return the embedded Raster from Objects.
+type polygon struct {
+	*canvas.Raster
+
+	// points are relative to the raster's position.
+	points []fyne.Position
+	fill   color.NRGBA
+}
+
+func newPolygon(fill color.Color) *polygon {
+	p := &polygon{fill: color.NRGBAModel.Convert(fill).(color.NRGBA)}
+	p.Raster = canvas.NewRaster(p.generate)
+	return p
+}
+
+// setPoints moves and resizes the polygon to cover points, which are in the parent's coordinates.
+func (p *polygon) setPoints(points []fyne.Position) {
+	if len(points) == 0 {
+		p.points = nil
+		p.Resize(fyne.NewSize(0, 0))
+		return
+	}
+
+	topLeft, bottomRight := points[0], points[0]
+	for _, pt := range points {
+		topLeft = fyne.NewPos(fyne.Min(topLeft.X, pt.X), fyne.Min(topLeft.Y, pt.Y))
+		bottomRight = fyne.NewPos(fyne.Max(bottomRight.X, pt.X), fyne.Max(bottomRight.Y, pt.Y))
+	}
+
+	p.points = p.points[:0]
+	for _, pt := range points {
+		p.points = append(p.points, pt.Subtract(topLeft))
+	}
+	p.Move(topLeft)
+	// Resizing the raster regenerates it, so only refresh explicitly when the size is unchanged.
+	size := fyne.NewSize(bottomRight.X-topLeft.X, bottomRight.Y-topLeft.Y)
+	if size == p.Size() {
+		p.Refresh()
+	} else {
+		p.Resize(size)
+	}
+}
+
+// generate fills the polygon a scanline at a time using the even-odd rule.
+func (p *polygon) generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	size := p.Size()
+	if len(p.points) < 3 || size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+
+	scaleX := float64(w) / float64(size.Width)
+	scaleY := float64(h) / float64(size.Height)
+	var crossings []float64
+	for y := 0; y < h; y++ {
+		rowY := (float64(y) + 0.5) / scaleY
+		crossings = crossings[:0]
+		for idx, a := range p.points {
+			b := p.points[(idx+1)%len(p.points)]
+			ay, by := float64(a.Y), float64(b.Y)
+			if (ay <= rowY) != (by <= rowY) {
+				crossings = append(crossings, float64(a.X)+(rowY-ay)/(by-ay)*float64(b.X-a.X))
+			}
+		}
+		sort.Float64s(crossings)
+
+		for idx := 0; idx+1 < len(crossings); idx += 2 {
+			start := int(math.Max(math.Ceil(crossings[idx]*scaleX-0.5), 0))
+			end := int(math.Min(math.Floor(crossings[idx+1]*scaleX-0.5), float64(w-1)))
+			for x := start; x <= end; x++ {
+				img.SetNRGBA(x, y, p.fill)
+			}
+		}
+	}
+	return img
+}
@@ -0,0 +1,127 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image"
+	"image/color"
+	"math"
+)
+
+const defaultLineWidth = 2
+
+type LineStyle int
+
+const (
+	LineSolid LineStyle = iota
+	LineDashed
+	LineDotted
+)
+
+// dashPattern is the length of each dash and of the gap after it, in multiples of the line width.
+func (l LineStyle) dashPattern() (float64, float64) {
+	switch l {
+	case LineDashed:
+		return 3, 2
+	case LineDotted:
+		return 0, 2
+	default:
+		return 1, 0
+	}
+}
+
+// polyline strokes a dashed or dotted path into a raster covering its bounding box, canvas.Line cannot dash.
+// Like polygon, renderers must return the embedded Raster from Objects.
+type polyline struct {
+	*canvas.Raster
+
+	// paths are the separate runs of the line, relative to the raster's position.
+	paths  [][]fyne.Position
+	stroke color.NRGBA
+	width  float32
+	style  LineStyle
+}
+
+func newPolyline(stroke color.Color, width float32, style LineStyle) *polyline {
+	p := &polyline{stroke: color.NRGBAModel.Convert(stroke).(color.NRGBA), width: width, style: style}
+	p.Raster = canvas.NewRaster(p.generate)
+	return p
+}
+
+// setPoints moves and resizes the polyline to cover points, which are in the parent's coordinates,
+// leaving room for the width of the stroke.
+func (p *polyline) setPoints(points []fyne.Position) {
+	p.setPaths([][]fyne.Position{points})
+}
+
+// setPaths is setPoints for a line broken into separate runs, such as one cut where it leaves the plot.
+func (p *polyline) setPaths(paths [][]fyne.Position) {
+	var topLeft, bottomRight fyne.Position
+	count := 0
+	for _, path := range paths {
+		for _, pt := range path {
+			if count == 0 {
+				topLeft, bottomRight = pt, pt
+			}
+			topLeft = fyne.NewPos(fyne.Min(topLeft.X, pt.X), fyne.Min(topLeft.Y, pt.Y))
+			bottomRight = fyne.NewPos(fyne.Max(bottomRight.X, pt.X), fyne.Max(bottomRight.Y, pt.Y))
+			count++
+		}
+	}
+	if count == 0 {
+		p.paths = nil
+		p.Resize(fyne.NewSize(0, 0))
+		return
+	}
+	topLeft = topLeft.SubtractXY(p.width, p.width)
+	bottomRight = bottomRight.AddXY(p.width, p.width)
+
+	p.paths = p.paths[:0]
+	for _, path := range paths {
+		relative := make([]fyne.Position, len(path))
+		for idx, pt := range path {
+			relative[idx] = pt.Subtract(topLeft)
+		}
+		p.paths = append(p.paths, relative)
+	}
+	p.Move(topLeft)
+	// Resizing the raster regenerates it, so only refresh explicitly when the size is unchanged.
+	size := fyne.NewSize(bottomRight.X-topLeft.X, bottomRight.Y-topLeft.Y)
+	if size == p.Size() {
+		p.Refresh()
+	} else {
+		p.Resize(size)
+	}
+}
+
+// generate walks the path half a pixel at a time, stamping the stroke wherever the dash pattern is on.
+func (p *polyline) generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	size := p.Size()
+	if len(p.paths) == 0 || size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+
+	scale := float64(w) / float64(size.Width)
+	width := float64(p.width) * scale
+	on, off := p.style.dashPattern()
+	on, off = math.Max(on*width, 0.5), off*width
+	const step = 0.5
+
+	for _, points := range p.paths {
+		travelled := 0.0
+		for idx := 1; idx < len(points); idx++ {
+			x1, y1 := float64(points[idx-1].X)*scale, float64(points[idx-1].Y)*scale
+			x2, y2 := float64(points[idx].X)*scale, float64(points[idx].Y)*scale
+			length := math.Hypot(x2-x1, y2-y1)
+			for d := 0.0; d < length; d += step {
+				if math.Mod(travelled+d, on+off) < on {
+					t := d / length
+					stampDisc(img, x1+(x2-x1)*t, y1+(y2-y1)*t, width/2, p.stroke)
+				}
+			}
+			travelled += length
+		}
+	}
+	return img
+}
@@ -0,0 +1,339 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image/color"
+	"log"
+	"math"
+)
+
+const defaultRadarMinRadius = 60
+
+// RadarSeries is a named set of values, one per axis of the chart, drawn with Color when set.
+type RadarSeries struct {
+	Name   string
+	Values []float64
+	Color  color.Color
+}
+
+// RadarChart plots each series as a polygon over axes spread evenly around a circle, the first pointing up.
+// Every axis shares one scale, from zero or the smallest value out to the largest.
+type RadarChart struct {
+	widget.BaseWidget
+	canvas fyne.Canvas
+
+	title  string
+	axes   []string
+	series []RadarSeries
+
+	fillOpacity        float32
+	dotDiameter        float32
+	suggestedTickCount int
+	tickGenerator      TickGenerator
+
+	hoverFormat func(float64) string
+	tickFormat  func(float64) string
+}
+
+func NewRadarChart(canvas fyne.Canvas, title string, axes []string, series []RadarSeries) *RadarChart {
+	rc := &RadarChart{canvas: canvas, title: title, axes: axes, series: series,
+		fillOpacity:        defaultFillOpacity,
+		dotDiameter:        defaultMarkerDiameter,
+		suggestedTickCount: defaultSuggestedTickCount,
+		hoverFormat:        defaultHoverFormat,
+		tickFormat:         defaultTickFormat,
+	}
+	rc.ExtendBaseWidget(rc)
+	rc.Refresh()
+
+	return rc
+}
+
+func (r *RadarChart) CreateRenderer() fyne.WidgetRenderer {
+	titleLbl := canvas.NewText(r.title, theme.ForegroundColor())
+	titleLbl.TextSize = theme.TextSize() + 6
+	titleLbl.Hide()
+
+	return &radarChartRenderer{radarChart: r, titleLbl: titleLbl}
+}
+
+func (r *RadarChart) UpdateData(axes []string, series []RadarSeries) {
+	r.axes = axes
+	r.series = series
+	r.Refresh()
+}
+
+// SetFillOpacity sets how opaque the series polygons are, from 0 (outline only) to 1 (the full series color).
+// Values outside that range are clamped.
+func (r *RadarChart) SetFillOpacity(opacity float32) {
+	r.fillOpacity = max(0, min(opacity, 1))
+	r.Refresh()
+}
+
+func (r *RadarChart) UpdateDotDiameter(diameter float32) {
+	r.dotDiameter = diameter
+	r.Refresh()
+}
+
+func (r *RadarChart) UpdateSuggestedTickCount(count int) {
+	r.suggestedTickCount = count
+	r.Refresh()
+}
+
+// SetTickGenerator picks the ring values, passing nil restores the default.
+func (r *RadarChart) SetTickGenerator(g TickGenerator) {
+	r.tickGenerator = g
+	r.Refresh()
+}
+
+func (r *RadarChart) UpdateHoverFormat(f func(float642 float64) string) {
+	r.hoverFormat = f
+	r.Refresh()
+}
+
+// UpdateTickFormat sets the format of the ring labels.
+func (r *RadarChart) UpdateTickFormat(f func(input float64) string) {
+	r.tickFormat = f
+	r.Refresh()
+}
+
+// axisAngle is the clockwise angle from twelve o'clock of the idx'th axis.
+func (r *RadarChart) axisAngle(idx int) float64 {
+	return float64(idx) * 2 * math.Pi / float64(len(r.axes))
+}
+
+func (r *RadarChart) hoverValue(series RadarSeries, idx int, value float64) string {
+	display := r.axes[idx] + ": " + r.hoverFormat(value)
+	if series.Name != "" {
+		display = series.Name + ", " + display
+	}
+	return display
+}
+
+type radarChartRenderer struct {
+	radarChart *RadarChart
+
+	titleLbl   *canvas.Text
+	axisLabels []*canvas.Text
+	spokes     []*canvas.Line
+	// rings holds the edges of each grid ring, ringValues its level and ringLabels the level's label.
+	rings      [][]*canvas.Line
+	ringValues []float64
+	ringLabels []*canvas.Text
+
+	fills    []*polygon
+	outlines [][]*canvas.Line
+	dots     [][]*dot
+
+	radialAxis axis
+}
+
+func (r *radarChartRenderer) Destroy() {
+
+}
+
+func (r *radarChartRenderer) Layout(size fyne.Size) {
+	chart := r.radarChart
+	titleSize := visibleTextSize(r.titleLbl)
+	r.titleLbl.Move(fyne.NewPos(size.Width/2-titleSize.Width/2, theme.Padding()))
+	r.titleLbl.Resize(titleSize)
+
+	top := titleSize.Height + 2*theme.Padding()
+	lblMax := r.axisLabelMax()
+	available := fyne.NewSize(size.Width-2*lblMax.Width-4*theme.Padding(), size.Height-top-2*lblMax.Height-3*theme.Padding())
+	radius := fyne.Max(fyne.Min(available.Width, available.Height)/2, 0)
+	center := fyne.NewPos(size.Width/2, top+(size.Height-top)/2)
+	at := func(idx int, v float64) fyne.Position {
+		return pointAt(center, chart.axisAngle(idx), radius*r.radialAxis.normalize(v))
+	}
+
+	for idx, spoke := range r.spokes {
+		spoke.Position1 = center
+		spoke.Position2 = pointAt(center, chart.axisAngle(idx), radius)
+
+		lbl := r.axisLabels[idx]
+		lblSize := lbl.MinSize()
+		angle := chart.axisAngle(idx)
+		anchor := pointAt(center, angle, radius+theme.Padding())
+		// Hang labels off the end of their spoke, centering them on the axes pointing straight up or down.
+		x := anchor.X - lblSize.Width/2 + float32(math.Sin(angle))*lblSize.Width/2
+		y := anchor.Y - lblSize.Height/2 - float32(math.Cos(angle))*lblSize.Height/2
+		lbl.Move(fyne.NewPos(x, y))
+	}
+
+	for ringIdx, edges := range r.rings {
+		v := r.ringValues[ringIdx]
+		for idx, edge := range edges {
+			edge.Position1 = at(idx, v)
+			edge.Position2 = at((idx+1)%len(edges), v)
+		}
+		// Ring labels sit just inside their ring so the outermost one stays clear of the first axis label.
+		r.ringLabels[ringIdx].Move(at(0, v).AddXY(theme.Padding()/2, 0))
+	}
+
+	for seriesIdx, dots := range r.dots {
+		var points []fyne.Position
+		for idx, dt := range dots {
+			pt := at(idx, chart.series[seriesIdx].Values[idx])
+			points = append(points, pt)
+			dt.Resize(fyne.NewSize(chart.dotDiameter, chart.dotDiameter))
+			dt.Move(pt.SubtractXY(chart.dotDiameter/2, chart.dotDiameter/2))
+		}
+		for idx, edge := range r.outlines[seriesIdx] {
+			edge.Position1 = points[idx]
+			edge.Position2 = points[(idx+1)%len(points)]
+		}
+		if r.fills[seriesIdx] != nil {
+			r.fills[seriesIdx].setPoints(points)
+		}
+	}
+}
+
+func (r *radarChartRenderer) axisLabelMax() fyne.Size {
+	lblMax := fyne.NewSize(0, 0)
+	for _, lbl := range r.axisLabels {
+		lblMax = lblMax.Max(lbl.MinSize())
+	}
+	return lblMax
+}
+
+func (r *radarChartRenderer) MinSize() fyne.Size {
+	titleSize := visibleTextSize(r.titleLbl)
+	lblMax := r.axisLabelMax()
+	diameter := float32(2 * defaultRadarMinRadius)
+	return fyne.NewSize(fyne.Max(titleSize.Width, diameter+2*lblMax.Width)+4*theme.Padding(),
+		titleSize.Height+diameter+2*lblMax.Height+5*theme.Padding())
+}
+
+func (r *radarChartRenderer) Objects() []fyne.CanvasObject {
+	cos := []fyne.CanvasObject{r.titleLbl}
+	for _, edges := range r.rings {
+		for _, edge := range edges {
+			cos = append(cos, edge)
+		}
+	}
+	for _, spoke := range r.spokes {
+		cos = append(cos, spoke)
+	}
+	for _, lbl := range r.ringLabels {
+		cos = append(cos, lbl)
+	}
+	for _, lbl := range r.axisLabels {
+		cos = append(cos, lbl)
+	}
+	for _, f := range r.fills {
+		if f != nil {
+			cos = append(cos, f.Raster)
+		}
+	}
+	for _, edges := range r.outlines {
+		for _, edge := range edges {
+			cos = append(cos, edge)
+		}
+	}
+	for _, dots := range r.dots {
+		for _, dt := range dots {
+			cos = append(cos, dt)
+		}
+	}
+	return cos
+}
+
+func (r *radarChartRenderer) Refresh() {
+	chart := r.radarChart
+	if chart.title != "" {
+		r.titleLbl.Text = chart.title
+		r.titleLbl.Refresh()
+		r.titleLbl.Show()
+	} else {
+		r.titleLbl.Hide()
+	}
+
+	r.axisLabels = nil
+	r.spokes = nil
+	gridColor := theme.DisabledColor()
+	for _, name := range chart.axes {
+		r.axisLabels = append(r.axisLabels, canvas.NewText(name, theme.ForegroundColor()))
+		r.spokes = append(r.spokes, canvas.NewLine(gridColor))
+	}
+
+	// The rings grow out from zero at the center.
+	r.radialAxis = axis{normalizer: linearNormalizer{}}
+	r.radialAxis.include(0)
+	for _, series := range chart.series {
+		for idx, v := range series.Values {
+			if idx >= len(chart.axes) {
+				break
+			}
+			r.radialAxis.include(v)
+		}
+	}
+	// All zero values would leave nothing to normalize against.
+	if r.radialAxis.min == r.radialAxis.max {
+		r.radialAxis.max++
+	}
+	r.radialAxis.dataRange = r.radialAxis.max - r.radialAxis.min
+
+	r.refreshRings(gridColor)
+
+	r.fills = nil
+	r.outlines = nil
+	r.dots = nil
+	for seriesIdx, series := range chart.series {
+		c := defaultSeriesColor(seriesIdx, series.Color)
+		var fill *polygon
+		var edges []*canvas.Line
+		var dots []*dot
+		// A series only forms a polygon when it has a value for every axis.
+		if len(series.Values) >= len(chart.axes) && len(chart.axes) > 0 {
+			if chart.fillOpacity > 0 {
+				fillColor := color.NRGBAModel.Convert(c).(color.NRGBA)
+				fillColor.A = uint8(float32(fillColor.A) * chart.fillOpacity)
+				fill = newPolygon(fillColor)
+			}
+			for idx := range chart.axes {
+				edge := canvas.NewLine(c)
+				edge.StrokeWidth = 2
+				edges = append(edges, edge)
+				dots = append(dots, newDot(chart.canvas, chart.hoverValue(series, idx, series.Values[idx]), MarkerCircle, c))
+			}
+		}
+		r.fills = append(r.fills, fill)
+		r.outlines = append(r.outlines, edges)
+		r.dots = append(r.dots, dots)
+	}
+}
+
+// refreshRings builds a ring for every tick beyond the center, which is the lowest value on the scale.
+func (r *radarChartRenderer) refreshRings(gridColor color.Color) {
+	r.rings = nil
+	r.ringValues = nil
+	r.ringLabels = nil
+	if r.radialAxis.dataRange == 0 || len(r.radarChart.axes) < 3 {
+		return
+	}
+
+	ticks, err := orDefaultTicks(r.radarChart.tickGenerator).Ticks(r.radialAxis.min, r.radialAxis.max, r.radarChart.suggestedTickCount)
+	if err != nil {
+		log.Println("error generating ring ticks")
+		return
+	}
+	ticks = r.radialAxis.fitTicks(ticks)
+	for _, tk := range ticks {
+		if tk <= r.radialAxis.min {
+			continue
+		}
+		var edges []*canvas.Line
+		for range r.radarChart.axes {
+			edges = append(edges, canvas.NewLine(gridColor))
+		}
+		lbl := canvas.NewText(r.radarChart.tickFormat(tk), theme.ForegroundColor())
+		lbl.TextSize = theme.TextSize() - 2
+		r.rings = append(r.rings, edges)
+		r.ringValues = append(r.ringValues, tk)
+		r.ringLabels = append(r.ringLabels, lbl)
+	}
+}
@@ -0,0 +1,251 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"math"
+)
+
+type ScatterChart struct {
+	*BaseChart
+	canvas fyne.Canvas
+
+	xData       []float64
+	yData       []float64
+	pointLabels []string
+
+	shape       MarkerShape
+	color       color.Color
+	dotDiameter float32
+
+	// sizes drives each marker's diameter when set, see BubbleChart.
+	sizes          []float64
+	minDiameter    float32
+	maxDiameter    float32
+	showSizeLegend bool
+	sizeFormat     func(float64) string
+
+	hoverFormat func(x, y float64) string
+}
+
+func (s *ScatterChart) CreateRenderer() fyne.WidgetRenderer {
+	bcr := s.BaseChart.CreateRenderer().(*baseChartRenderer)
+
+	return &scatterChartRenderer{
+		baseChartRenderer: bcr,
+		scatterChart:      s,
+	}
+}
+
+func NewScatterChart(canvas fyne.Canvas, title string, x, y []float64) *ScatterChart {
+	sc := newScatterChart(canvas, title, x, y)
+	sc.ExtendBaseWidget(sc)
+	sc.Refresh()
+
+	return sc
+}
+
+func newScatterChart(canvas fyne.Canvas, title string, x, y []float64) *ScatterChart {
+	return &ScatterChart{BaseChart: newBaseChart(title, nil, defaultMinHeight, defaultSuggestedTickCount),
+		canvas:      canvas,
+		xData:       x,
+		yData:       y,
+		dotDiameter: defaultMarkerDiameter,
+		minDiameter: defaultMinBubbleDiameter,
+		maxDiameter: defaultMaxBubbleDiameter,
+		sizeFormat:  defaultHoverFormat,
+		hoverFormat: defaultXYHoverFormat,
+	}
+}
+
+func (s *ScatterChart) UpdateData(x, y []float64) {
+	s.xData = x
+	s.yData = y
+	s.Refresh()
+}
+
+// UpdatePointLabels names each point, the name is shown ahead of the formatted values when hovering.
+func (s *ScatterChart) UpdatePointLabels(labels []string) {
+	s.pointLabels = labels
+	s.Refresh()
+}
+
+func (s *ScatterChart) UpdateHoverFormat(f func(x, y float64) string) {
+	s.hoverFormat = f
+	s.Refresh()
+}
+
+func (s *ScatterChart) UpdateMarkerShape(shape MarkerShape) {
+	s.shape = shape
+	s.Refresh()
+}
+
+// UpdateMarkerColor sets the marker color, nil uses the theme's primary color.
+func (s *ScatterChart) UpdateMarkerColor(c color.Color) {
+	s.color = c
+	s.Refresh()
+}
+
+func (s *ScatterChart) UpdateDotDiameter(diameter float32) {
+	s.dotDiameter = diameter
+	s.Refresh()
+}
+
+func (s *ScatterChart) hoverValue(idx int) string {
+	display := s.hoverFormat(s.xData[idx], s.yData[idx])
+	if idx < len(s.pointLabels) && s.pointLabels[idx] != "" {
+		display = s.pointLabels[idx] + " " + display
+	}
+	if idx < len(s.sizes) {
+		display += " size " + s.sizeFormat(s.sizes[idx])
+	}
+	return display
+}
+
+// maxAbsSize is the size given the maximum diameter, all other sizes are scaled by area relative to it.
+func (s *ScatterChart) maxAbsSize() float64 {
+	maxSize := 0.0
+	for _, size := range s.sizes {
+		maxSize = math.Max(maxSize, math.Abs(size))
+	}
+	return maxSize
+}
+
+// sizeDiameter maps size to a diameter whose area is proportional to it, bounded by the min and max diameters.
+func (s *ScatterChart) sizeDiameter(size, maxSize float64) float32 {
+	if maxSize == 0 {
+		return s.minDiameter
+	}
+	diameter := s.maxDiameter * float32(math.Sqrt(math.Abs(size)/maxSize))
+	return fyne.Max(diameter, s.minDiameter)
+}
+
+func (s *ScatterChart) diameter(idx int, maxSize float64) float32 {
+	if idx >= len(s.sizes) {
+		return s.dotDiameter
+	}
+	return s.sizeDiameter(s.sizes[idx], maxSize)
+}
+
+type scatterChartRenderer struct {
+	*baseChartRenderer
+	scatterChart *ScatterChart
+
+	data []*dot
+
+	sizeLegend       []*canvas.Circle
+	sizeLegendLabels []*canvas.Text
+}
+
+func (s *scatterChartRenderer) Destroy() {
+
+}
+
+func (s *scatterChartRenderer) Layout(size fyne.Size) {
+	s.baseChartRenderer.Layout(size)
+
+	xOffset := s.xOffset()
+
+	availableHeight := s.availableHeight(size)
+	availableWidth := s.availableWidth(size, xOffset)
+
+	reqBottom := s.requiredBottomHeight()
+	maxSize := s.scatterChart.maxAbsSize()
+	for idx, dt := range s.data {
+		diameter := s.scatterChart.diameter(idx, maxSize)
+		dt.Resize(fyne.NewSize(diameter, diameter))
+		center := fyne.NewPos(xOffset+availableWidth*s.xAxis.normalize(s.scatterChart.xData[idx]),
+			size.Height-reqBottom-availableHeight*s.yAxis.normalize(s.scatterChart.yData[idx]))
+		dt.Move(center.SubtractXY(diameter/2, diameter/2))
+		// Points beyond a fixed range are left off the plot.
+		if s.yAxis.contains(s.scatterChart.yData[idx]) {
+			dt.Show()
+		} else {
+			dt.Hide()
+		}
+	}
+
+	s.layoutSizeLegend(size, maxSize)
+}
+
+// layoutSizeLegend lines the reference bubbles up along the top right of the plot with their sizes beneath.
+func (s *scatterChartRenderer) layoutSizeLegend(size fyne.Size, maxSize float64) {
+	x := size.Width - theme.Padding()
+	top := s.requiredTopHeight() - s.insets.top
+	for idx := len(s.sizeLegend) - 1; idx >= 0; idx-- {
+		circle, lbl := s.sizeLegend[idx], s.sizeLegendLabels[idx]
+		diameter := s.scatterChart.sizeDiameter(sizeLegendValue(idx, maxSize), maxSize)
+		lblSize := lbl.MinSize()
+		cellWidth := fyne.Max(diameter, lblSize.Width)
+		x -= cellWidth
+		circle.Resize(fyne.NewSize(diameter, diameter))
+		circle.Move(fyne.NewPos(x+cellWidth/2-diameter/2, top+s.scatterChart.maxDiameter-diameter))
+		lbl.Move(fyne.NewPos(x+cellWidth/2-lblSize.Width/2, top+s.scatterChart.maxDiameter+theme.Padding()/2))
+		x -= theme.Padding()
+	}
+}
+
+// sizeLegendValue is the size shown by the idx'th reference bubble, quarter, half and full size.
+func sizeLegendValue(idx int, maxSize float64) float64 {
+	return maxSize / math.Pow(2, float64(sizeLegendCount-1-idx))
+}
+
+func (s *scatterChartRenderer) MinSize() fyne.Size {
+	xCellWidth := s.xLblMax.Width + 2
+	return fyne.NewSize(s.xOffset()+float32(len(s.xLabels))*xCellWidth,
+		s.requiredTopHeight()+s.requiredBottomHeight()+s.scatterChart.minHeight)
+}
+
+func (s *scatterChartRenderer) Objects() []fyne.CanvasObject {
+	cos := s.baseChartRenderer.Objects()
+	for _, d := range s.data {
+		cos = append(cos, d)
+	}
+	for idx, circle := range s.sizeLegend {
+		cos = append(cos, circle, s.sizeLegendLabels[idx])
+	}
+	return cos
+}
+
+func (s *scatterChartRenderer) Refresh() {
+	s.yAxis = axis{normalizer: linearNormalizer{}}
+	s.data = nil
+
+	count := min(len(s.scatterChart.xData), len(s.scatterChart.yData))
+	s.refreshNumericXAxis(s.scatterChart.xData[:count])
+	c := s.scatterChart.color
+	if c == nil {
+		c = theme.PrimaryColor()
+	}
+	for idx, y := range s.scatterChart.yData[:count] {
+		s.yAxis.include(y)
+		s.data = append(s.data, newDot(s.scatterChart.canvas, s.scatterChart.hoverValue(idx), s.scatterChart.shape, c))
+	}
+	s.yAxis.dataRange = s.yAxis.max - s.yAxis.min
+
+	s.refreshSizeLegend()
+	s.baseChartRenderer.Refresh()
+}
+
+func (s *scatterChartRenderer) refreshSizeLegend() {
+	s.sizeLegend = nil
+	s.sizeLegendLabels = nil
+	s.insets.top = 0
+	maxSize := s.scatterChart.maxAbsSize()
+	if !s.scatterChart.showSizeLegend || maxSize == 0 {
+		return
+	}
+
+	for idx := 0; idx < sizeLegendCount; idx++ {
+		circle := canvas.NewCircle(color.Transparent)
+		circle.StrokeColor = theme.ForegroundColor()
+		circle.StrokeWidth = 1
+		lbl := canvas.NewText(s.scatterChart.sizeFormat(sizeLegendValue(idx, maxSize)), theme.ForegroundColor())
+		lbl.TextSize = theme.CaptionTextSize()
+		s.sizeLegend = append(s.sizeLegend, circle)
+		s.sizeLegendLabels = append(s.sizeLegendLabels, lbl)
+	}
+	s.insets.top = s.scatterChart.maxDiameter + s.sizeLegendLabels[0].MinSize().Height + 2*theme.Padding()
+}
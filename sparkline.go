@@ -0,0 +1,272 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"image"
+	"image/color"
+	"math"
+)
+
+const (
+	defaultSparklineWidth  = 60
+	defaultSparklineHeight = 16
+	sparklineStrokeWidth   = 1.5
+	sparklineMarkerRadius  = 2
+	sparklineBarGap        = 1
+)
+
+type SparklineStyle int
+
+const (
+	SparklineLine SparklineStyle = iota
+	// SparklineBar draws a bar per value from zero, negative values in the negative color.
+	SparklineBar
+	// SparklineWinLoss draws an equal sized block above the middle for positive values and below it for negative ones.
+	SparklineWinLoss
+)
+
+// SparklineMarkers picks which values are highlighted, they combine with |.
+type SparklineMarkers int
+
+const (
+	SparklineMarkLast SparklineMarkers = 1 << iota
+	SparklineMarkMin
+	SparklineMarkMax
+
+	SparklineMarkNone SparklineMarkers = 0
+)
+
+// Sparkline is a small chart without titles, axes or hover, painted into a single raster
+// so that many can be shown at once, such as in the rows of a list.
+type Sparkline struct {
+	widget.BaseWidget
+
+	data    []float64
+	style   SparklineStyle
+	markers SparklineMarkers
+
+	color, negativeColor color.Color
+}
+
+func NewSparkline(data []float64) *Sparkline {
+	s := &Sparkline{data: data}
+	s.ExtendBaseWidget(s)
+
+	return s
+}
+
+func (s *Sparkline) CreateRenderer() fyne.WidgetRenderer {
+	sr := &sparklineRenderer{sparkline: s}
+	sr.raster = canvas.NewRaster(sr.generate)
+	return sr
+}
+
+func (s *Sparkline) UpdateData(data []float64) {
+	s.data = data
+	s.Refresh()
+}
+
+func (s *Sparkline) SetStyle(style SparklineStyle) {
+	s.style = style
+	s.Refresh()
+}
+
+// SetMarkers highlights the last, smallest and largest values, with a dot on a line or by coloring the bar.
+// Win/loss sparklines are not marked.
+func (s *Sparkline) SetMarkers(markers SparklineMarkers) {
+	s.markers = markers
+	s.Refresh()
+}
+
+// UpdateColors sets the line or bar color and the color of negative bars and losses, nil uses the theme's
+// primary and error colors.
+func (s *Sparkline) UpdateColors(c, negative color.Color) {
+	s.color = c
+	s.negativeColor = negative
+	s.Refresh()
+}
+
+// markerColor is the color a marked value is drawn in, and whether it is marked at all.
+func (s *Sparkline) markerColor(idx, minIdx, maxIdx int) (color.NRGBA, bool) {
+	switch {
+	case s.markers&SparklineMarkMax != 0 && idx == maxIdx:
+		return color.NRGBAModel.Convert(theme.SuccessColor()).(color.NRGBA), true
+	case s.markers&SparklineMarkMin != 0 && idx == minIdx:
+		return color.NRGBAModel.Convert(theme.ErrorColor()).(color.NRGBA), true
+	case s.markers&SparklineMarkLast != 0 && idx == len(s.data)-1:
+		return color.NRGBAModel.Convert(theme.ForegroundColor()).(color.NRGBA), true
+	}
+	return color.NRGBA{}, false
+}
+
+type sparklineRenderer struct {
+	sparkline *Sparkline
+
+	raster *canvas.Raster
+}
+
+func (s *sparklineRenderer) Destroy() {
+
+}
+
+func (s *sparklineRenderer) Layout(size fyne.Size) {
+	s.raster.Resize(size)
+}
+
+func (s *sparklineRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(defaultSparklineWidth, defaultSparklineHeight)
+}
+
+func (s *sparklineRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{s.raster}
+}
+
+func (s *sparklineRenderer) Refresh() {
+	s.raster.Refresh()
+}
+
+func (s *sparklineRenderer) generate(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	chart := s.sparkline
+	size := s.raster.Size()
+	if len(chart.data) == 0 || w <= 0 || h <= 0 || size.Width <= 0 {
+		return img
+	}
+
+	lineColor := color.NRGBAModel.Convert(theme.PrimaryColor()).(color.NRGBA)
+	if chart.color != nil {
+		lineColor = color.NRGBAModel.Convert(chart.color).(color.NRGBA)
+	}
+	negativeColor := color.NRGBAModel.Convert(theme.ErrorColor()).(color.NRGBA)
+	if chart.negativeColor != nil {
+		negativeColor = color.NRGBAModel.Convert(chart.negativeColor).(color.NRGBA)
+	}
+	// Work in pixels, scaling the fixed sizes by the canvas scale.
+	scale := float64(w) / float64(size.Width)
+
+	minIdx, maxIdx := 0, 0
+	for idx, v := range chart.data {
+		if v < chart.data[minIdx] {
+			minIdx = idx
+		}
+		if v > chart.data[maxIdx] {
+			maxIdx = idx
+		}
+	}
+	low, high := chart.data[minIdx], chart.data[maxIdx]
+
+	switch chart.style {
+	case SparklineBar:
+		s.generateBars(img, math.Min(low, 0), math.Max(high, 0), minIdx, maxIdx, lineColor, negativeColor, scale)
+	case SparklineWinLoss:
+		s.generateWinLoss(img, lineColor, negativeColor, scale)
+	default:
+		s.generateLine(img, low, high, minIdx, maxIdx, lineColor, scale)
+	}
+	return img
+}
+
+func (s *sparklineRenderer) generateLine(img *image.NRGBA, low, high float64, minIdx, maxIdx int, c color.NRGBA, scale float64) {
+	data := s.sparkline.data
+	bounds := img.Bounds()
+	// Inset by the marker radius so dots at the ends and extremes are not clipped.
+	inset := sparklineMarkerRadius * scale
+	width, height := float64(bounds.Dx())-2*inset, float64(bounds.Dy())-2*inset
+	point := func(idx int) (float64, float64) {
+		x := inset + width/2
+		if len(data) > 1 {
+			x = inset + width*float64(idx)/float64(len(data)-1)
+		}
+		y := inset + height/2
+		if high > low {
+			y = inset + height*(1-(data[idx]-low)/(high-low))
+		}
+		return x, y
+	}
+
+	radius := sparklineStrokeWidth * scale / 2
+	for idx := 1; idx < len(data); idx++ {
+		x1, y1 := point(idx - 1)
+		x2, y2 := point(idx)
+		steps := int(math.Ceil(math.Hypot(x2-x1, y2-y1) * 2))
+		for step := 0; step <= steps; step++ {
+			t := float64(step) / float64(max(steps, 1))
+			stampDisc(img, x1+(x2-x1)*t, y1+(y2-y1)*t, radius, c)
+		}
+	}
+	for idx := range data {
+		if mc, ok := s.sparkline.markerColor(idx, minIdx, maxIdx); ok {
+			x, y := point(idx)
+			stampDisc(img, x, y, sparklineMarkerRadius*scale, mc)
+		}
+	}
+}
+
+func (s *sparklineRenderer) generateBars(img *image.NRGBA, low, high float64, minIdx, maxIdx int, c, negative color.NRGBA, scale float64) {
+	data := s.sparkline.data
+	bounds := img.Bounds()
+	slot := float64(bounds.Dx()) / float64(len(data))
+	gap := math.Min(sparklineBarGap*scale, slot/2)
+	valueY := func(v float64) float64 {
+		if high <= low {
+			return float64(bounds.Dy())
+		}
+		return float64(bounds.Dy()) * (1 - (v-low)/(high-low))
+	}
+
+	zero := valueY(0)
+	for idx, v := range data {
+		barColor := c
+		if v < 0 {
+			barColor = negative
+		}
+		if mc, ok := s.sparkline.markerColor(idx, minIdx, maxIdx); ok {
+			barColor = mc
+		}
+		y := valueY(v)
+		fillRect(img, float64(idx)*slot, math.Min(y, zero), float64(idx+1)*slot-gap, math.Max(y, zero), barColor)
+	}
+}
+
+func (s *sparklineRenderer) generateWinLoss(img *image.NRGBA, c, negative color.NRGBA, scale float64) {
+	data := s.sparkline.data
+	bounds := img.Bounds()
+	slot := float64(bounds.Dx()) / float64(len(data))
+	gap := math.Min(sparklineBarGap*scale, slot/2)
+	middle := float64(bounds.Dy()) / 2
+
+	for idx, v := range data {
+		left, right := float64(idx)*slot, float64(idx+1)*slot-gap
+		switch {
+		case v > 0:
+			fillRect(img, left, 0, right, middle-gap/2, c)
+		case v < 0:
+			fillRect(img, left, middle+gap/2, right, float64(bounds.Dy()), negative)
+		}
+	}
+}
+
+// stampDisc fills the pixels whose centers lie within radius of x, y.
+func stampDisc(img *image.NRGBA, x, y, radius float64, c color.NRGBA) {
+	bounds := img.Bounds()
+	for py := max(int(y-radius), bounds.Min.Y); py <= min(int(y+radius), bounds.Max.Y-1); py++ {
+		for px := max(int(x-radius), bounds.Min.X); px <= min(int(x+radius), bounds.Max.X-1); px++ {
+			if math.Hypot(float64(px)+0.5-x, float64(py)+0.5-y) <= math.Max(radius, 0.5) {
+				img.SetNRGBA(px, py, c)
+			}
+		}
+	}
+}
+
+// fillRect fills the pixels whose centers lie between left, top and right, bottom.
+func fillRect(img *image.NRGBA, left, top, right, bottom float64, c color.NRGBA) {
+	bounds := img.Bounds()
+	for py := max(int(math.Round(top)), bounds.Min.Y); py < min(int(math.Round(bottom)), bounds.Max.Y); py++ {
+		for px := max(int(math.Round(left)), bounds.Min.X); px < min(int(math.Round(right)), bounds.Max.X); px++ {
+			img.SetNRGBA(px, py, c)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func generateSparkline(s *Sparkline, w, h int) *image.NRGBA {
+	sr := s.CreateRenderer().(*sparklineRenderer)
+	sr.raster.Resize(fyne.NewSize(float32(w), float32(h)))
+	return sr.generate(w, h).(*image.NRGBA)
+}
+
+func TestSparklineMarkerColors(t *testing.T) {
+	s := NewSparkline([]float64{3, 1, 5, 2})
+	s.SetMarkers(SparklineMarkMin | SparklineMarkMax | SparklineMarkLast)
+
+	expected := map[int]color.Color{1: theme.ErrorColor(), 2: theme.SuccessColor(), 3: theme.ForegroundColor()}
+	for idx := range s.data {
+		c, ok := s.markerColor(idx, 1, 2)
+		want, marked := expected[idx]
+		if ok != marked {
+			t.Error("unexpected marking of value", idx)
+			continue
+		}
+		if marked && c != color.NRGBAModel.Convert(want).(color.NRGBA) {
+			t.Error("unexpected marker color for value", idx, c)
+		}
+	}
+
+	s.SetMarkers(SparklineMarkNone)
+	if _, ok := s.markerColor(2, 1, 2); ok {
+		t.Error("expected no markers")
+	}
+}
+
+func TestSparklineMarksTheMaximum(t *testing.T) {
+	s := NewSparkline([]float64{3, 1, 5, 2})
+	s.SetMarkers(SparklineMarkMax)
+	img := generateSparkline(s, defaultSparklineWidth, defaultSparklineHeight)
+
+	// The maximum is the third of four points, at the top of the inset area.
+	x := sparklineMarkerRadius + (defaultSparklineWidth-2*sparklineMarkerRadius)*2/3
+	if c := img.NRGBAAt(x, sparklineMarkerRadius); c != color.NRGBAModel.Convert(theme.SuccessColor()).(color.NRGBA) {
+		t.Error("expected the maximum to be marked", c)
+	}
+}
+
+func TestSparklineEmptyAndSingleValue(t *testing.T) {
+	for _, style := range []SparklineStyle{SparklineLine, SparklineBar, SparklineWinLoss} {
+		s := NewSparkline(nil)
+		s.SetStyle(style)
+		s.SetMarkers(SparklineMarkLast)
+		img := generateSparkline(s, defaultSparklineWidth, defaultSparklineHeight)
+		for _, p := range img.Pix {
+			if p != 0 {
+				t.Error("expected an empty sparkline to draw nothing, style", style)
+				break
+			}
+		}
+
+		s.UpdateData([]float64{4})
+		generateSparkline(s, defaultSparklineWidth, defaultSparklineHeight)
+	}
+
+	s := NewSparkline([]float64{4})
+	s.SetMarkers(SparklineMarkLast)
+	img := generateSparkline(s, defaultSparklineWidth, defaultSparklineHeight)
+	if c := img.NRGBAAt(defaultSparklineWidth/2, defaultSparklineHeight/2); c != color.NRGBAModel.Convert(theme.ForegroundColor()).(color.NRGBA) {
+		t.Error("expected a single value to be marked in the middle", c)
+	}
+}
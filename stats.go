@@ -0,0 +1,40 @@
+package fynecharts
+
+import (
+	"math"
+	"sort"
+)
+
+func sortedCopy(samples []float64) []float64 {
+	sorted := append([]float64{}, samples...)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+// quantile linearly interpolates the q'th quantile, 0 to 1, of already sorted samples.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (pos-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+func stdDev(samples []float64) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	mean := 0.0
+	for _, s := range samples {
+		mean += s
+	}
+	mean /= float64(len(samples))
+
+	variance := 0.0
+	for _, s := range samples {
+		variance += (s - mean) * (s - mean)
+	}
+	return math.Sqrt(variance / float64(len(samples)-1))
+}
@@ -0,0 +1,61 @@
+package fynecharts
+
+import (
+	"sort"
+)
+
+// TickGenerator picks the values labelled along a value axis running from min to max.
+type TickGenerator interface {
+	Ticks(min, max float64, suggestedTickCount int) ([]float64, error)
+}
+
+var _ TickGenerator = TalbotTicks{}
+var _ TickGenerator = FixedTicks{}
+
+// TalbotTicks searches for the best scoring labelling using the extended Wilkinson algorithm by Talbot, Lin and Hanrahan.
+// Q, Weights and Legibility use the defaults when unset.
+type TalbotTicks struct {
+	// Q lists the nice step sizes, see DefaultQ.
+	Q           []float64
+	Weights     *TickWeights
+	Containment Containment
+	// Legibility scores a labelling from 0 to 1, for example penalising long labels.
+	Legibility func(lMin, lMax, lStep float64) float64
+}
+
+// DefaultTickGenerator is the generator charts use until one is set, Talbot ticks containing the data.
+func DefaultTickGenerator() TalbotTicks {
+	return TalbotTicks{Q: DefaultQ(), Weights: DefaultTickWeights(), Containment: ContainmentContainData, Legibility: DefaultLegibility}
+}
+
+// orDefaultTicks is g, or the default generator when g is nil.
+func orDefaultTicks(g TickGenerator) TickGenerator {
+	if g == nil {
+		return DefaultTickGenerator()
+	}
+	return g
+}
+
+func (t TalbotTicks) Ticks(min, max float64, suggestedTickCount int) ([]float64, error) {
+	q, w, legibility := t.Q, t.Weights, t.Legibility
+	if len(q) == 0 {
+		q = DefaultQ()
+	}
+	if w == nil {
+		w = DefaultTickWeights()
+	}
+	if legibility == nil {
+		legibility = DefaultLegibility
+	}
+	ticks, _, _, _, err := generateTicks(min, max, suggestedTickCount, t.Containment, q, w, legibility)
+	return ticks, err
+}
+
+// FixedTicks labels the given values, those outside the axis are left out.
+type FixedTicks []float64
+
+func (f FixedTicks) Ticks(min, max float64, _ int) ([]float64, error) {
+	ticks := ticksInRange(f, min, max)
+	sort.Float64s(ticks)
+	return ticks, nil
+}
@@ -0,0 +1,70 @@
+package fynecharts
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFixedTicksWithinAxis(t *testing.T) {
+	ticks, err := FixedTicks{100, 0, 50, 150, -10}.Ticks(0, 120, 4)
+	if err != nil {
+		t.Error("got error generating ticks", err)
+	}
+	if !slices.Equal(ticks, []float64{0, 50, 100}) {
+		t.Error("expected the sorted values within the axis", ticks)
+	}
+}
+
+func TestTalbotTicksZeroValueContainsData(t *testing.T) {
+	ticks, err := TalbotTicks{}.Ticks(3, 108, 7)
+	if err != nil {
+		t.Error("got error generating ticks", err)
+	}
+	if !slices.Equal(ticks, []float64{0, 20, 40, 60, 80, 100, 120}) {
+		t.Error("expected ticks containing the data", ticks)
+	}
+}
+
+func TestTalbotTicksContainment(t *testing.T) {
+	expected := map[Containment][]float64{
+		ContainmentContainData: {0, 20, 40, 60, 80, 100, 120},
+		ContainmentWithinData:  {15, 30, 45, 60, 75, 90, 105},
+		ContainmentFree:        {0, 20, 40, 60, 80, 100},
+	}
+	for containment, want := range expected {
+		ticks, _ := TalbotTicks{Containment: containment}.Ticks(3, 108, 7)
+		if !slices.Equal(ticks, want) {
+			t.Error("unexpected ticks for containment", containment, ticks)
+		}
+	}
+}
+
+func TestTalbotTicksQ(t *testing.T) {
+	ticks, _ := TalbotTicks{Q: []float64{3}}.Ticks(3, 108, 7)
+	if !slices.Equal(ticks, []float64{0, 30, 60, 90, 120}) {
+		t.Error("expected steps of three", ticks)
+	}
+}
+
+func TestTalbotTicksWeights(t *testing.T) {
+	coverage := &TickWeights{Simplicity: 0.01, Coverage: 1, Density: 1}
+	ticks, _ := TalbotTicks{Weights: coverage}.Ticks(3, 108, 7)
+	if !slices.Equal(ticks, []float64{0, 18, 36, 54, 72, 90, 108}) {
+		t.Error("expected coverage to win over round steps", ticks)
+	}
+
+	// Without simplicity and density weights the search would never end.
+	for _, w := range []*TickWeights{{Simplicity: 1}, {}} {
+		if _, err := (TalbotTicks{Weights: w}).Ticks(3, 108, 7); err == nil {
+			t.Error("expected an error for weights", *w)
+		}
+	}
+}
+
+func TestScaleTicksPreferGenerator(t *testing.T) {
+	a := axis{min: 1, max: 1000}
+	ticks, _ := AxisScale{Type: ScaleLog}.ticks(a, 4, FixedTicks{5, 500})
+	if !slices.Equal(ticks, []float64{5, 500}) {
+		t.Error("expected a set generator to replace the decade ticks", ticks)
+	}
+}
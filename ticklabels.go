@@ -11,21 +11,29 @@ const (
 	dlamchP = dlamchB * dlamchE
 )
 
-type containment int
+// Containment is where Talbot ticks may fall relative to the data, the zero value contains the data.
+type Containment int
 
 const (
-	containmentFree containment = iota
-	containmentContainData
-	containmentWithinData
+	// ContainmentContainData keeps the first and last ticks at or beyond the ends of the data.
+	ContainmentContainData Containment = iota
+	// ContainmentWithinData keeps every tick inside the data.
+	ContainmentWithinData
+	// ContainmentFree lets the ticks start and end anywhere.
+	ContainmentFree
 )
 
 // Based upon the paper by Justin Talbot, Sharon Lin, and Pat Hanrahan. http://vis.stanford.edu/files/2010-TickLabels-InfoVis.pdf
-func generateTicks(min, max float64, suggestedTickCount int, containment containment, Q []float64, w *weights, legibility func(lMin, lMax, lStep float64) float64) ([]float64, float64, float64, int, error) {
+func generateTicks(min, max float64, suggestedTickCount int, containment Containment, Q []float64, w *TickWeights, legibility func(lMin, lMax, lStep float64) float64) ([]float64, float64, float64, int, error) {
 
 	eps := dlamchP * 100
 	if min > max {
 		return nil, 0, 0, 0, errors.New("min must not be larger than max")
 	}
+	// The search only ends as the simplicity and density scores fall away.
+	if w.Simplicity <= 0 || w.Density <= 0 {
+		return nil, 0, 0, 0, errors.New("tick weights need a positive simplicity and density")
+	}
 
 	r := max - min
 	if r < eps {
@@ -80,18 +88,18 @@ outside:
 					fracStep := step / float64(j)
 					kStep := step * float64(k-1)
 					minStart := (math.Floor(max/step) - float64(k-1)) * float64(j)
-					maxStart := math.Ceil(max/step) * float64(j)
+					maxStart := math.Ceil(min/step) * float64(j)
 					for start := minStart; start <= maxStart && start != start-1; start++ {
 						lMin := start * fracStep
-						lMax := lMin * kStep
+						lMax := lMin + kStep
 						switch containment {
-						case containmentFree:
+						case ContainmentFree:
 
-						case containmentWithinData:
+						case ContainmentWithinData:
 							if lMin < min || max < lMax {
 								continue
 							}
-						case containmentContainData:
+						case ContainmentContainData:
 							if min < lMin || lMax < max {
 								continue
 							}
@@ -148,7 +156,7 @@ func minimumAbsoluteMagnitude(a, b float64) int {
 func maxSimplicity(q float64, Q []float64, skip int) (float64, error) {
 	for idx, val := range Q {
 		if val == q {
-			return 1 - float64(idx)/(float64(len(Q))-1) - (float64(skip) + 1), nil
+			return 1 - qRank(idx, Q) - float64(skip) + 1, nil
 		}
 	}
 	return 0, errors.New("invalid q for Q")
@@ -163,12 +171,20 @@ func simplicity(q float64, Q []float64, skip int, lMin, lMax, lStep float64) (fl
 			if (m < eps || lStep-m < eps) && lMin <= 0 && 0 <= lMax {
 				val = 1
 			}
-			return 1 - float64(idx)/(float64(len(Q))-1) - float64(skip) + val, nil
+			return 1 - qRank(idx, Q) - float64(skip) + val, nil
 		}
 	}
 	return 0, errors.New("error computing simplicity")
 }
 
+// qRank is how far down Q its idx'th step is, from 0 for the first to 1 for the last.
+func qRank(idx int, Q []float64) float64 {
+	if len(Q) < 2 {
+		return 0
+	}
+	return float64(idx) / float64(len(Q)-1)
+}
+
 func maxDensity(k, suggestedTickCount int) float64 {
 	if k < suggestedTickCount {
 		return 1
@@ -176,7 +192,7 @@ func maxDensity(k, suggestedTickCount int) float64 {
 	return 2 - float64(k-1)/float64(suggestedTickCount-1)
 }
 
-func density(k, suggestedTickCount int, max, min, lMin, lMax float64) float64 {
+func density(k, suggestedTickCount int, min, max, lMin, lMax float64) float64 {
 	rho := float64(k-1) / (lMax - lMin)
 	rhot := float64(suggestedTickCount-1) / (math.Max(lMax, max) - math.Min(min, lMin))
 	d := rho / rhot
@@ -208,30 +224,35 @@ type tick struct {
 	label string
 }
 
-type weights struct {
-	simplicity float64
-	coverage   float64
-	density    float64
-	legibility float64
+// TickWeights balances the parts of the Talbot score, preferring round steps (Simplicity), ticks spanning
+// the data closely (Coverage), a tick count near the suggested one (Density) and readable labels (Legibility).
+// Simplicity and Density must be positive, as they bound the search.
+type TickWeights struct {
+	Simplicity float64
+	Coverage   float64
+	Density    float64
+	Legibility float64
 }
 
-func (w *weights) score(simplicity, coverage, density, legibility float64) float64 {
-	return w.simplicity*simplicity + w.coverage*coverage + w.density*density + w.legibility*legibility
+func (w *TickWeights) score(simplicity, coverage, density, legibility float64) float64 {
+	return w.Simplicity*simplicity + w.Coverage*coverage + w.Density*density + w.Legibility*legibility
 }
 
-func defaultWeights() *weights {
-	return &weights{
-		simplicity: 0.25,
-		coverage:   0.2,
-		density:    0.5,
-		legibility: 0.05,
+func DefaultTickWeights() *TickWeights {
+	return &TickWeights{
+		Simplicity: 0.25,
+		Coverage:   0.2,
+		Density:    0.5,
+		Legibility: 0.05,
 	}
 }
 
-func defaultQ() []float64 {
+// DefaultQ is the list of nice step sizes, earlier entries are preferred.
+func DefaultQ() []float64 {
 	return []float64{1, 5, 2, 2.5, 3, 4, 1.5, 7, 6, 8, 9}
 }
 
-func defaultLegibility(_, _, _ float64) float64 {
+// DefaultLegibility scores every labelling as equally legible.
+func DefaultLegibility(_, _, _ float64) float64 {
 	return 1
 }
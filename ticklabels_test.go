@@ -6,7 +6,7 @@ import (
 )
 
 func TestTickLabels(t *testing.T) {
-	idxs, step, q, magnitude, err := generateTicks(3, 108, 7, containmentWithinData, defaultQ(), defaultWeights(), defaultLegibility)
+	idxs, step, q, magnitude, err := generateTicks(3, 108, 7, ContainmentWithinData, DefaultQ(), DefaultTickWeights(), DefaultLegibility)
 	if err != nil {
 		t.Error("got error generating ticks", err)
 	}
@@ -4,22 +4,69 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/theme"
+	"image/color"
 	"math"
+	"time"
 )
 
 const (
 	defaultDotDiameter = 18
+	defaultFillOpacity = 0.35
+)
+
+// TimeSeries is a named line of values sharing the chart's labels or times, drawn with Color when set.
+// Named series are listed in the chart's legend.
+type TimeSeries struct {
+	Name          string
+	Values        []float64
+	Color         color.Color
+	Interpolation Interpolation
+	Marker        MarkerShape
+	LineStyle     LineStyle
+	// LineWidth is the stroke width of the line, 0 uses the default.
+	LineWidth float32
+	// Axis binds the series to the right y-axis, stacked areas only stack on series bound to the same axis.
+	Axis AxisSide
+}
+
+func (s TimeSeries) lineWidth() float32 {
+	if s.LineWidth <= 0 {
+		return defaultLineWidth
+	}
+	return s.LineWidth
+}
+
+type AreaMode int
+
+const (
+	// AreaNone draws only the lines and dots.
+	AreaNone AreaMode = iota
+	// AreaFill fills each series down to the baseline.
+	AreaFill
+	// AreaStacked stacks each series on top of the previous one, filling the space between them.
+	AreaStacked
+	// AreaBand fills the range between the first two series.
+	AreaBand
 )
 
 type TimeSeriesChart struct {
 	*BaseChart
 	canvas fyne.Canvas
 
-	data []float64
+	series []TimeSeries
+	// times places each datum proportionally along a time axis, when nil the data is laid out in label columns.
+	times []time.Time
 
 	dotDiameter float32
+	areaMode    AreaMode
+	fillOpacity float32
 
-	hoverFormat func(float64) string
+	legendPosition LegendPosition
+	// hidden holds the indices of series toggled off, they keep their index so colors stay the same.
+	hidden map[int]bool
+
+	hoverFormat    func(float64) string
+	timeTickFormat func(time.Time) string
 }
 
 func (t *TimeSeriesChart) CreateRenderer() fyne.WidgetRenderer {
@@ -32,10 +79,15 @@ func (t *TimeSeriesChart) CreateRenderer() fyne.WidgetRenderer {
 }
 
 func NewTimeSeriesChart(canvas fyne.Canvas, title string, labels []string, data []float64) *TimeSeriesChart {
+	return NewMultiTimeSeriesChart(canvas, title, labels, []TimeSeries{{Values: data}})
+}
+
+func NewMultiTimeSeriesChart(canvas fyne.Canvas, title string, labels []string, series []TimeSeries) *TimeSeriesChart {
 	tc := &TimeSeriesChart{BaseChart: newBaseChart(title, labels, defaultMinHeight, defaultSuggestedTickCount),
 		canvas:      canvas,
-		data:        data,
+		series:      series,
 		dotDiameter: defaultDotDiameter,
+		fillOpacity: defaultFillOpacity,
 		hoverFormat: defaultHoverFormat,
 	}
 	tc.ExtendBaseWidget(tc)
@@ -44,14 +96,43 @@ func NewTimeSeriesChart(canvas fyne.Canvas, title string, labels []string, data
 	return tc
 }
 
+func NewTimeSeriesChartWithTimes(canvas fyne.Canvas, title string, times []time.Time, data []float64) *TimeSeriesChart {
+	tc := NewTimeSeriesChart(canvas, title, nil, nil)
+	tc.UpdateTimeData(times, data)
+
+	return tc
+}
+
 func (t *TimeSeriesChart) UpdateHoverFormat(f func(float642 float64) string) {
 	t.hoverFormat = f
 	t.Refresh()
 }
 
 func (t *TimeSeriesChart) UpdateData(lbls []string, data []float64) {
+	t.UpdateSeries(lbls, []TimeSeries{{Values: data}})
+}
+
+func (t *TimeSeriesChart) UpdateSeries(lbls []string, series []TimeSeries) {
 	t.xLabels = lbls
-	t.data = data
+	t.times = nil
+	t.series = series
+	t.Refresh()
+}
+
+func (t *TimeSeriesChart) UpdateTimeData(times []time.Time, data []float64) {
+	t.UpdateTimeSeries(times, []TimeSeries{{Values: data}})
+}
+
+func (t *TimeSeriesChart) UpdateTimeSeries(times []time.Time, series []TimeSeries) {
+	t.xLabels = nil
+	t.times = times
+	t.series = series
+	t.Refresh()
+}
+
+// UpdateTimeTickFormat overrides the automatic time axis label format, passing nil restores it.
+func (t *TimeSeriesChart) UpdateTimeTickFormat(f func(time.Time) string) {
+	t.timeTickFormat = f
 	t.Refresh()
 }
 
@@ -60,12 +141,50 @@ func (t *TimeSeriesChart) UpdateDotDiameter(diameter float32) {
 	t.Refresh()
 }
 
+func (t *TimeSeriesChart) SetAreaMode(mode AreaMode) {
+	t.areaMode = mode
+	t.Refresh()
+}
+
+func (t *TimeSeriesChart) SetLegendPosition(position LegendPosition) {
+	t.legendPosition = position
+	t.Refresh()
+}
+
+// SetSeriesVisible shows or hides the idx'th series, as tapping its legend entry does.
+func (t *TimeSeriesChart) SetSeriesVisible(idx int, visible bool) {
+	if t.hidden == nil {
+		t.hidden = map[int]bool{}
+	}
+	if visible {
+		delete(t.hidden, idx)
+	} else {
+		t.hidden[idx] = true
+	}
+	t.Refresh()
+}
+
+// SetFillOpacity sets how opaque area fills are, from 0 (invisible) to 1 (the full series color).
+// Values outside that range are clamped.
+func (t *TimeSeriesChart) SetFillOpacity(opacity float32) {
+	t.fillOpacity = max(0, min(opacity, 1))
+	t.Refresh()
+}
+
 type timeSeriesChartRenderer struct {
 	*baseChartRenderer
 	timeSeriesChart *TimeSeriesChart
 
-	data         []*dot
-	connectLines []*canvas.Line
+	data [][]*dot
+	// connectLines draws each solid series' interpolated path, one line per segment.
+	connectLines [][]*canvas.Line
+	// styledLines draws the path of each dashed or dotted series, nil for solid ones.
+	styledLines []*polyline
+	// fills has a nil entry for each fill whose series is hidden.
+	fills  []*polygon
+	legend *legend
+	// stacked holds the plotted value of every datum, which is the running total when the areas are stacked.
+	stacked [][]float64
 }
 
 func (t *timeSeriesChartRenderer) Destroy() {
@@ -78,27 +197,104 @@ func (t *timeSeriesChartRenderer) Layout(size fyne.Size) {
 	xOffset := t.xOffset()
 
 	availableHeight := t.availableHeight(size)
-	columnWidth := t.columnWidth(size, xOffset)
-
 	reqBottom := t.requiredBottomHeight()
-	if len(t.data) > 0 {
-		var previousPos *fyne.Position
-		for idx, d := range t.timeSeriesChart.data {
-			dt := t.data[idx]
-			scale := t.yAxis.normalize(d)
-			dt.Resize(fyne.NewSize(t.timeSeriesChart.dotDiameter, t.timeSeriesChart.dotDiameter))
-			xCellOffset := float32(idx) * columnWidth
-			rectPos := fyne.NewPos(xOffset+xCellOffset+columnWidth/2-dt.Size().Width/2,
-				size.Height-reqBottom-(availableHeight*scale)-dt.Size().Height/2)
-			if previousPos != nil {
-				l := t.connectLines[idx-1]
-				l.Position1 = (*previousPos).AddXY(dt.Size().Width/2, dt.Size().Height/2)
-				l.Position2 = rectPos.AddXY(dt.Size().Width/2, dt.Size().Height/2)
+	plotTop, plotBottom := size.Height-reqBottom-availableHeight, size.Height-reqBottom
+	diameter := t.timeSeriesChart.dotDiameter
+	paths := make([][]fyne.Position, len(t.data))
+	for seriesIdx, dots := range t.data {
+		valueAxis := t.valueAxis(t.timeSeriesChart.series[seriesIdx].Axis)
+		var centers []fyne.Position
+		for idx, dt := range dots {
+			value := t.stacked[seriesIdx][idx]
+			scale := valueAxis.normalize(value)
+			dt.Resize(fyne.NewSize(diameter, diameter))
+			center := fyne.NewPos(t.xCenter(idx, size, xOffset), size.Height-reqBottom-(availableHeight*scale))
+			centers = append(centers, center)
+			dt.Move(center.SubtractXY(diameter/2, diameter/2))
+			// Values beyond a fixed range have no place on the plot, their lines are cut at its edge.
+			if valueAxis.contains(value) {
+				dt.Show()
+			} else {
+				dt.Hide()
+			}
+		}
+
+		paths[seriesIdx] = interpolate(centers, t.timeSeriesChart.series[seriesIdx].Interpolation)
+		for idx, l := range t.connectLines[seriesIdx] {
+			var ok bool
+			l.Position1, l.Position2, ok = clipSegment(paths[seriesIdx][idx], paths[seriesIdx][idx+1], plotTop, plotBottom)
+			if ok {
+				l.Show()
+			} else {
+				l.Hide()
 			}
-			previousPos = &rectPos
-			dt.Move(rectPos)
+		}
+		if styled := t.styledLines[seriesIdx]; styled != nil {
+			styled.setPaths(clipPath(paths[seriesIdx], plotTop, plotBottom))
 		}
 	}
+
+	for idx, fill := range t.fills {
+		if fill == nil {
+			continue
+		}
+		valueAxis := t.valueAxis(t.timeSeriesChart.series[idx].Axis)
+		baselineY := size.Height - reqBottom - availableHeight*valueAxis.normalize(areaBaseline(valueAxis))
+		upper, lower := t.fillBounds(idx, paths, baselineY)
+		outline := append([]fyne.Position{}, upper...)
+		for i := len(lower) - 1; i >= 0; i-- {
+			outline = append(outline, lower[i])
+		}
+		fill.setPoints(clipPolygon(outline, plotTop, plotBottom))
+	}
+
+	t.legend.layout(t.baseChartRenderer, size)
+}
+
+// fillBounds returns the upper and lower edges of the idx'th fill, in the same left to right order,
+// following the series' interpolated paths.
+func (t *timeSeriesChartRenderer) fillBounds(idx int, paths [][]fyne.Position, baselineY float32) ([]fyne.Position, []fyne.Position) {
+	upper := paths[idx]
+	if t.timeSeriesChart.areaMode == AreaBand {
+		return upper, paths[idx+1]
+	}
+	if below := t.stackedOn(idx); below >= 0 {
+		// Only stack on the part of the series below that this one covers.
+		lower := paths[below]
+		if len(upper) > 0 {
+			end := upper[len(upper)-1].X
+			for len(lower) > 0 && lower[len(lower)-1].X > end {
+				lower = lower[:len(lower)-1]
+			}
+		}
+		return upper, lower
+	}
+
+	var lower []fyne.Position
+	for _, pt := range upper {
+		lower = append(lower, fyne.NewPos(pt.X, baselineY))
+	}
+	return upper, lower
+}
+
+// stackedOn is the index of the series the idx'th is stacked on, the nearest visible one before it on the same axis,
+// or -1 when it sits on the baseline.
+func (t *timeSeriesChartRenderer) stackedOn(idx int) int {
+	chart := t.timeSeriesChart
+	if chart.areaMode != AreaStacked {
+		return -1
+	}
+	for below := idx - 1; below >= 0; below-- {
+		if !chart.hidden[below] && chart.series[below].Axis == chart.series[idx].Axis {
+			return below
+		}
+	}
+	return -1
+}
+
+// areaBaseline is zero, held within the axis so fills never extend off the plot.
+func areaBaseline(a *axis) float64 {
+	return math.Min(math.Max(0, a.min), a.max)
 }
 
 func (t *timeSeriesChartRenderer) MinSize() fyne.Size {
@@ -116,23 +312,38 @@ func (t *timeSeriesChartRenderer) MinSize() fyne.Size {
 	}
 
 	xCellWidth := t.xLblMax.Width + 2
-	return fyne.NewSize(float32(len(t.timeSeriesChart.xLabels))*xCellWidth,
-		titleSize.Height+xLblSize.Height+t.xLblMax.Height+float32(paddingCount)*theme.Padding()+t.timeSeriesChart.minHeight)
+	return fyne.NewSize(float32(len(t.xLabels))*xCellWidth+t.insets.left+t.insets.right+t.rightAxisWidth(),
+		titleSize.Height+xLblSize.Height+t.xLblMax.Height+float32(paddingCount)*theme.Padding()+t.timeSeriesChart.minHeight+t.insets.top+t.insets.bottom)
 }
 
 func (t *timeSeriesChartRenderer) Objects() []fyne.CanvasObject {
 	cos := t.baseChartRenderer.Objects()
-	for _, l := range t.connectLines {
-		cos = append(cos, l)
+	for _, f := range t.fills {
+		if f != nil {
+			cos = append(cos, f.Raster)
+		}
 	}
-	for _, d := range t.data {
-		cos = append(cos, d)
+	for _, lines := range t.connectLines {
+		for _, l := range lines {
+			cos = append(cos, l)
+		}
+	}
+	for _, styled := range t.styledLines {
+		if styled != nil {
+			cos = append(cos, styled.Raster)
+		}
 	}
-	return cos
+	for _, dots := range t.data {
+		for _, d := range dots {
+			cos = append(cos, d)
+		}
+	}
+	return append(cos, t.legend.objects()...)
 }
 
 func (t *timeSeriesChartRenderer) Refresh() {
 	t.yAxis = axis{normalizer: linearNormalizer{}}
+	t.y2Axis = nil
 	/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
 	//for _, ln := range t.connectLines {
 	//	ln.Hide()
@@ -141,30 +352,119 @@ func (t *timeSeriesChartRenderer) Refresh() {
 	//	dt.Hide()
 	//}
 	t.connectLines = nil
+	t.styledLines = nil
 	t.data = nil
-	for _, datum := range t.timeSeriesChart.data {
-		t.yAxis.max = math.Max(t.yAxis.max, datum)
-		t.yAxis.min = math.Min(t.yAxis.min, datum)
-		t.data = append(t.data, newDot(t.timeSeriesChart.canvas, t.timeSeriesChart.hoverFormat(datum)))
-		/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
-		//if idx >= len(t.data) {
-		//	t.data = append(t.data, newDot(t.timeSeriesChart.canvas, t.timeSeriesChart.hoverFormat(datum)))
-		//} else {
-		//	t.data[idx].updateDisplayValue(t.timeSeriesChart.hoverFormat(datum))
-		//	t.data[idx].Show()
-		//}
-
-		l := canvas.NewLine(theme.PrimaryColor())
-		l.StrokeWidth = 2
-		t.connectLines = append(t.connectLines, l)
-		/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
-		//if idx >= len(t.connectLines) {
-		//	l := canvas.NewLine(theme.PrimaryColor())
-		//	l.StrokeWidth = 2
-		//	t.connectLines = append(t.connectLines, l)
-		//}
-	}
-	t.yAxis.dataRange = t.yAxis.max - t.yAxis.min
+	t.fills = nil
+	t.stacked = nil
+	t.refreshTimeAxis(t.timeSeriesChart.times, t.timeSeriesChart.timeTickFormat)
+	for seriesIdx, series := range t.timeSeriesChart.series {
+		c := defaultSeriesColor(seriesIdx, series.Color)
+		var dots []*dot
+		var lines []*canvas.Line
+		var styled *polyline
+		var stacked []float64
+		if t.timeSeriesChart.hidden[seriesIdx] {
+			t.data = append(t.data, nil)
+			t.connectLines = append(t.connectLines, nil)
+			t.styledLines = append(t.styledLines, nil)
+			t.stacked = append(t.stacked, stacked)
+			continue
+		}
+		var below []float64
+		if belowIdx := t.stackedOn(seriesIdx); belowIdx >= 0 {
+			below = t.stacked[belowIdx]
+		}
+		valueAxis := t.valueAxis(series.Axis)
+		for idx, datum := range series.Values {
+			if t.xAxis != nil && idx >= len(t.timeSeriesChart.times) {
+				break
+			}
+			value := datum
+			if idx < len(below) {
+				value += below[idx]
+			}
+			valueAxis.include(value)
+			stacked = append(stacked, value)
+			dots = append(dots, newDot(t.timeSeriesChart.canvas, t.hoverValue(series, idx, datum), series.Marker, c))
+			/*** Commenting this out here for now, as reuse was keeping the layout from updating on data change. ***/
+			//if idx >= len(t.data) {
+			//	t.data = append(t.data, newDot(t.timeSeriesChart.canvas, t.timeSeriesChart.hoverFormat(datum)))
+			//} else {
+			//	t.data[idx].updateDisplayValue(t.timeSeriesChart.hoverFormat(datum))
+			//	t.data[idx].Show()
+			//}
+		}
+		if series.LineStyle != LineSolid {
+			styled = newPolyline(c, series.lineWidth(), series.LineStyle)
+		} else {
+			for idx := 1; idx < interpolatedLength(len(dots), series.Interpolation); idx++ {
+				l := canvas.NewLine(c)
+				l.StrokeWidth = series.lineWidth()
+				lines = append(lines, l)
+			}
+		}
+		t.data = append(t.data, dots)
+		t.connectLines = append(t.connectLines, lines)
+		t.styledLines = append(t.styledLines, styled)
+		t.stacked = append(t.stacked, stacked)
+	}
+	t.updateDataRanges()
 
+	t.refreshFills()
+	t.refreshLegend()
 	t.baseChartRenderer.Refresh()
 }
+
+func (t *timeSeriesChartRenderer) refreshLegend() {
+	chart := t.timeSeriesChart
+	var entries []*legendEntry
+	for seriesIdx, series := range chart.series {
+		if series.Name == "" {
+			continue
+		}
+		idx := seriesIdx
+		hidden := chart.hidden[idx]
+		entries = append(entries, newLegendEntry(series.Name, defaultSeriesColor(idx, series.Color), series.Marker, series.LineStyle, hidden,
+			func() { chart.SetSeriesVisible(idx, hidden) }))
+	}
+	t.legend = newLegend(chart.legendPosition, entries)
+	t.insets = t.legend.reserve()
+}
+
+func (t *timeSeriesChartRenderer) refreshFills() {
+	fillCount := len(t.timeSeriesChart.series)
+	switch t.timeSeriesChart.areaMode {
+	case AreaNone:
+		fillCount = 0
+	case AreaBand:
+		fillCount = min(fillCount, 1)
+		if len(t.timeSeriesChart.series) < 2 {
+			fillCount = 0
+		}
+	}
+
+	for idx := 0; idx < fillCount; idx++ {
+		hidden := t.timeSeriesChart.hidden[idx]
+		if t.timeSeriesChart.areaMode == AreaBand {
+			hidden = hidden || t.timeSeriesChart.hidden[idx+1]
+		}
+		if hidden {
+			t.fills = append(t.fills, nil)
+			continue
+		}
+		c := color.NRGBAModel.Convert(defaultSeriesColor(idx, t.timeSeriesChart.series[idx].Color)).(color.NRGBA)
+		c.A = uint8(float32(c.A) * t.timeSeriesChart.fillOpacity)
+		t.fills = append(t.fills, newPolygon(c))
+	}
+}
+
+func (t *timeSeriesChartRenderer) hoverValue(series TimeSeries, idx int, datum float64) string {
+	display := t.timeSeriesChart.hoverFormat(datum)
+	if series.Name != "" {
+		display = series.Name + ": " + display
+	}
+	if t.xAxis != nil {
+		display = t.formatTime(t.timeSeriesChart.times[idx]) + " " + display
+	}
+	return display
+}
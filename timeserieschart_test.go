@@ -0,0 +1,28 @@
+package fynecharts
+
+import (
+	"testing"
+)
+
+func TestStackedAreasOnlyStackOnTheSameAxis(t *testing.T) {
+	tc := NewMultiTimeSeriesChart(nil, "", []string{"a", "b"}, []TimeSeries{
+		{Values: []float64{1, 2}},
+		{Values: []float64{100, 200}, Axis: AxisRight},
+		{Values: []float64{3, 4}},
+		{Values: []float64{300, 400}, Axis: AxisRight},
+	})
+	tc.SetAreaMode(AreaStacked)
+	tr := tc.CreateRenderer().(*timeSeriesChartRenderer)
+
+	expected := []int{-1, -1, 0, 1}
+	for idx, below := range expected {
+		if on := tr.stackedOn(idx); on != below {
+			t.Error("series", idx, "expected to stack on", below, "got", on)
+		}
+	}
+
+	tc.SetSeriesVisible(0, false)
+	if on := tr.stackedOn(2); on != -1 {
+		t.Error("expected a hidden series to be skipped", on)
+	}
+}
@@ -0,0 +1,150 @@
+package fynecharts
+
+import (
+	"time"
+)
+
+type timeUnit int
+
+const (
+	timeUnitSecond timeUnit = iota
+	timeUnitMinute
+	timeUnitHour
+	timeUnitDay
+	timeUnitMonth
+	timeUnitYear
+)
+
+type timeInterval struct {
+	unit  timeUnit
+	count int
+}
+
+var timeIntervals = []timeInterval{
+	{timeUnitSecond, 1}, {timeUnitSecond, 5}, {timeUnitSecond, 15}, {timeUnitSecond, 30},
+	{timeUnitMinute, 1}, {timeUnitMinute, 5}, {timeUnitMinute, 15}, {timeUnitMinute, 30},
+	{timeUnitHour, 1}, {timeUnitHour, 3}, {timeUnitHour, 6}, {timeUnitHour, 12},
+	{timeUnitDay, 1}, {timeUnitDay, 2}, {timeUnitDay, 7},
+	{timeUnitMonth, 1}, {timeUnitMonth, 3}, {timeUnitMonth, 6},
+	{timeUnitYear, 1}, {timeUnitYear, 2}, {timeUnitYear, 5}, {timeUnitYear, 10}, {timeUnitYear, 25}, {timeUnitYear, 50}, {timeUnitYear, 100},
+}
+
+// approximate is only used to pick an interval, ticks are stepped using calendar arithmetic.
+func (ti timeInterval) approximate() time.Duration {
+	switch ti.unit {
+	case timeUnitSecond:
+		return time.Duration(ti.count) * time.Second
+	case timeUnitMinute:
+		return time.Duration(ti.count) * time.Minute
+	case timeUnitHour:
+		return time.Duration(ti.count) * time.Hour
+	case timeUnitDay:
+		return time.Duration(ti.count) * 24 * time.Hour
+	case timeUnitMonth:
+		return time.Duration(ti.count) * 30 * 24 * time.Hour
+	default:
+		return time.Duration(ti.count) * 365 * 24 * time.Hour
+	}
+}
+
+func (ti timeInterval) next(t time.Time) time.Time {
+	switch ti.unit {
+	case timeUnitSecond:
+		return t.Add(time.Duration(ti.count) * time.Second)
+	case timeUnitMinute:
+		return t.Add(time.Duration(ti.count) * time.Minute)
+	case timeUnitHour:
+		return t.Add(time.Duration(ti.count) * time.Hour)
+	case timeUnitDay:
+		return t.AddDate(0, 0, ti.count)
+	case timeUnitMonth:
+		return t.AddDate(0, ti.count, 0)
+	default:
+		return t.AddDate(ti.count, 0, 0)
+	}
+}
+
+// floor truncates t to the start of the interval containing it, aligning counts to their unit so that
+// 15 minute ticks land on the quarter hour, quarterly ticks on January, April, July and October and so on.
+func (ti timeInterval) floor(t time.Time) time.Time {
+	loc := t.Location()
+	switch ti.unit {
+	case timeUnitSecond:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second()-t.Second()%ti.count, 0, loc)
+	case timeUnitMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()-t.Minute()%ti.count, 0, 0, loc)
+	case timeUnitHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour()-t.Hour()%ti.count, 0, 0, 0, loc)
+	case timeUnitDay:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		if ti.count == 7 {
+			return day.AddDate(0, 0, -int((day.Weekday()+6)%7))
+		}
+		return day
+	case timeUnitMonth:
+		month := int(t.Month()) - 1
+		return time.Date(t.Year(), time.Month(month-month%ti.count+1), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year()-t.Year()%ti.count, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// layout picks a label format for the interval, adding the coarser date parts when the range spans them.
+func (ti timeInterval) layout(min, max time.Time) string {
+	sameDay := min.YearDay() == max.YearDay() && min.Year() == max.Year()
+	sameYear := min.Year() == max.Year()
+	switch ti.unit {
+	case timeUnitSecond:
+		if sameDay {
+			return "15:04:05"
+		}
+		return "Jan 2 15:04:05"
+	case timeUnitMinute, timeUnitHour:
+		if sameDay {
+			return "15:04"
+		}
+		return "Jan 2 15:04"
+	case timeUnitDay:
+		if sameYear {
+			return "Jan 2"
+		}
+		return "Jan 2 2006"
+	case timeUnitMonth:
+		return "Jan 2006"
+	default:
+		return "2006"
+	}
+}
+
+// generateTimeTicks returns calendar aligned ticks between min and max, choosing the finest interval that
+// produces no more than suggestedTickCount ticks, along with the time layout to format them with.
+func generateTimeTicks(min, max time.Time, suggestedTickCount int) ([]time.Time, string) {
+	if !max.After(min) {
+		return []time.Time{min}, timeInterval{unit: timeUnitMinute, count: 1}.layout(min, max)
+	}
+	if suggestedTickCount < 2 {
+		suggestedTickCount = 2
+	}
+
+	span := max.Sub(min)
+	interval := timeIntervals[len(timeIntervals)-1]
+	for _, ti := range timeIntervals {
+		if span/ti.approximate() < time.Duration(suggestedTickCount) {
+			interval = ti
+			break
+		}
+	}
+
+	var ticks []time.Time
+	for t := interval.floor(min); !t.After(max); t = interval.next(t) {
+		if !t.Before(min) {
+			ticks = append(ticks, t)
+		}
+	}
+
+	return ticks, interval.layout(min, max)
+}
+
+func timeValue(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
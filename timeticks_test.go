@@ -0,0 +1,43 @@
+package fynecharts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeTicksHours(t *testing.T) {
+	min := time.Date(2023, time.March, 4, 1, 20, 0, 0, time.UTC)
+	max := time.Date(2023, time.March, 4, 22, 45, 0, 0, time.UTC)
+	ticks, layout := generateTimeTicks(min, max, 8)
+	if layout != "15:04" {
+		t.Error("expected hour layout, got", layout)
+	}
+	if len(ticks) == 0 || len(ticks) > 8 {
+		t.Fatal("unexpected tick count", len(ticks))
+	}
+	for _, tk := range ticks {
+		if tk.Minute() != 0 || tk.Hour()%3 != 0 {
+			t.Error("tick not aligned to 3 hours", tk)
+		}
+		if tk.Before(min) || tk.After(max) {
+			t.Error("tick outside of range", tk)
+		}
+	}
+}
+
+func TestTimeTicksMonths(t *testing.T) {
+	min := time.Date(2022, time.February, 10, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2023, time.November, 2, 0, 0, 0, 0, time.UTC)
+	ticks, layout := generateTimeTicks(min, max, 8)
+	if layout != "Jan 2006" {
+		t.Error("expected month layout, got", layout)
+	}
+	for _, tk := range ticks {
+		if tk.Day() != 1 || (tk.Month()-1)%3 != 0 {
+			t.Error("tick not aligned to quarters", tk)
+		}
+	}
+	if ticks[0] != time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC) {
+		t.Error("unexpected first tick", ticks[0])
+	}
+}
@@ -0,0 +1,72 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// tooltip is hover text on a background, for charts that track the pointer themselves rather than through
+// a hoverable object per datum. It is positioned with its bottom left corner at the pointer.
+type tooltip struct {
+	widget.BaseWidget
+
+	text string
+	pos  fyne.Position
+}
+
+func newTooltip() *tooltip {
+	t := &tooltip{}
+	t.ExtendBaseWidget(t)
+	t.Hide()
+
+	return t
+}
+
+func (t *tooltip) showAt(text string, pos fyne.Position) {
+	t.text = text
+	t.pos = pos
+	t.Show()
+	t.Refresh()
+}
+
+func (t *tooltip) CreateRenderer() fyne.WidgetRenderer {
+	return &tooltipRenderer{
+		t:       t,
+		wrapper: canvas.NewRectangle(theme.BackgroundColor()),
+		display: widget.NewLabel(t.text),
+	}
+}
+
+type tooltipRenderer struct {
+	t *tooltip
+
+	wrapper *canvas.Rectangle
+	display *widget.Label
+}
+
+func (t *tooltipRenderer) Destroy() {
+
+}
+
+func (t *tooltipRenderer) Layout(size fyne.Size) {
+	t.wrapper.Resize(t.display.MinSize())
+	t.display.Resize(t.display.MinSize())
+}
+
+func (t *tooltipRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (t *tooltipRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{t.wrapper, t.display}
+}
+
+func (t *tooltipRenderer) Refresh() {
+	t.display.SetText(t.t.text)
+	topLeft := t.t.pos.SubtractXY(0, t.display.MinSize().Height)
+	t.display.Move(topLeft)
+	t.wrapper.Move(topLeft)
+	t.Layout(t.t.Size())
+}
@@ -0,0 +1,209 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"math"
+)
+
+type WaterfallMeasure int
+
+const (
+	// WaterfallRelative adds the step's Value to the running total.
+	WaterfallRelative WaterfallMeasure = iota
+	// WaterfallAbsolute sets the running total to the step's Value, such as an opening balance.
+	WaterfallAbsolute
+	// WaterfallTotal shows the running total so far, the step's Value is ignored.
+	WaterfallTotal
+)
+
+// WaterfallStep is a single column of a waterfall chart.
+type WaterfallStep struct {
+	Label   string
+	Value   float64
+	Measure WaterfallMeasure
+}
+
+// WaterfallChart is a BarChart whose bars float between the running total before and after each step,
+// with connectors carrying the total from one bar to the next.
+type WaterfallChart struct {
+	*BarChart
+
+	steps []WaterfallStep
+
+	increaseColor, decreaseColor, totalColor color.Color
+}
+
+func (w *WaterfallChart) CreateRenderer() fyne.WidgetRenderer {
+	bcr := w.BarChart.CreateRenderer().(*barChartRenderer)
+
+	return &waterfallChartRenderer{barChartRenderer: bcr, waterfallChart: w}
+}
+
+func NewWaterfallChart(canvas fyne.Canvas, title string, steps []WaterfallStep) *WaterfallChart {
+	wc := &WaterfallChart{BarChart: newBarChart(canvas, title, nil, nil)}
+	wc.ExtendBaseWidget(wc)
+	wc.UpdateData(steps)
+
+	return wc
+}
+
+func (w *WaterfallChart) UpdateData(steps []WaterfallStep) {
+	w.steps = steps
+	w.xLabels = nil
+	for _, step := range steps {
+		w.xLabels = append(w.xLabels, step.Label)
+	}
+	w.Refresh()
+}
+
+// UpdateSeries does nothing, the bars come from the steps passed to UpdateData.
+func (w *WaterfallChart) UpdateSeries(labels []string, series []BarSeries) {
+}
+
+// SetBaseline does nothing, waterfall bars float between running totals rather than growing from a baseline.
+func (w *WaterfallChart) SetBaseline(baseline float64) {
+}
+
+// SetBarMode does nothing, a waterfall has a single bar per step.
+func (w *WaterfallChart) SetBarMode(mode BarMode) {
+}
+
+// UpdateColors sets the colors of rising, falling and total bars, nil uses the theme's success, error and primary colors.
+func (w *WaterfallChart) UpdateColors(increase, decrease, total color.Color) {
+	w.increaseColor = increase
+	w.decreaseColor = decrease
+	w.totalColor = total
+	w.Refresh()
+}
+
+func (w *WaterfallChart) stepColor(step WaterfallStep) color.Color {
+	switch {
+	case step.Measure != WaterfallRelative:
+		if w.totalColor != nil {
+			return w.totalColor
+		}
+		return theme.PrimaryColor()
+	case step.Value >= 0:
+		if w.increaseColor != nil {
+			return w.increaseColor
+		}
+		return theme.SuccessColor()
+	default:
+		if w.decreaseColor != nil {
+			return w.decreaseColor
+		}
+		return theme.ErrorColor()
+	}
+}
+
+func (w *WaterfallChart) hoverValue(step WaterfallStep, total float64) string {
+	if step.Measure != WaterfallRelative {
+		return "Total: " + w.hoverFormat(total)
+	}
+	delta := w.hoverFormat(step.Value)
+	if step.Value >= 0 {
+		delta = "+" + delta
+	}
+	return "Change: " + delta + "\nTotal: " + w.hoverFormat(total)
+}
+
+type waterfallChartRenderer struct {
+	*barChartRenderer
+	waterfallChart *WaterfallChart
+
+	// totals holds the running total after each step, which the connector leaving its bar is drawn at.
+	totals     []float64
+	connectors []*canvas.Line
+}
+
+func (w *waterfallChartRenderer) Layout(size fyne.Size) {
+	w.barChartRenderer.Layout(size)
+	if len(w.data) == 0 {
+		return
+	}
+
+	xOffset := w.xOffset()
+	bars := w.data[0]
+	for idx, connector := range w.connectors {
+		from, to := bars[idx], bars[idx+1]
+		// A total beyond a fixed range has no place on the plot to carry across.
+		if w.yAxis.contains(w.totals[idx]) {
+			connector.Show()
+		} else {
+			connector.Hide()
+		}
+		if w.horizontal() {
+			x := xOffset + w.availableWidth(size, xOffset)*w.yAxis.normalize(w.totals[idx])
+			connector.Position1 = fyne.NewPos(x, from.Position().Y+from.Size().Height)
+			connector.Position2 = fyne.NewPos(x, to.Position().Y)
+			continue
+		}
+		y := size.Height - w.requiredBottomHeight() - w.availableHeight(size)*w.yAxis.normalize(w.totals[idx])
+		connector.Position1 = fyne.NewPos(from.Position().X+from.Size().Width, y)
+		connector.Position2 = fyne.NewPos(to.Position().X, y)
+	}
+}
+
+func (w *waterfallChartRenderer) Objects() []fyne.CanvasObject {
+	cos := w.barChartRenderer.Objects()
+	for _, connector := range w.connectors {
+		cos = append(cos, connector)
+	}
+	return cos
+}
+
+func (w *waterfallChartRenderer) Refresh() {
+	chart := w.waterfallChart
+	w.yAxis = axis{normalizer: linearNormalizer{}}
+	w.data = nil
+	w.spans = nil
+	w.totals = nil
+	w.connectors = nil
+
+	var bars []*bar
+	spans, totals := waterfallSpans(chart.steps)
+	for idx, step := range chart.steps {
+		w.yAxis.include(spans[idx].low)
+		w.yAxis.include(spans[idx].high)
+
+		br := newBar(chart.canvas, chart.hoverValue(step, totals[idx]), chart.stepColor(step))
+		br.updateOnTouched(chart.touched, 0, idx)
+		bars = append(bars, br)
+		if idx > 0 {
+			connector := canvas.NewLine(theme.ForegroundColor())
+			connector.StrokeWidth = 1
+			w.connectors = append(w.connectors, connector)
+		}
+	}
+	w.totals = totals
+	w.data = append(w.data, bars)
+	w.spans = append(w.spans, spans)
+	w.yAxis.dataRange = w.yAxis.max - w.yAxis.min
+
+	w.baseChartRenderer.Refresh()
+	w.refreshBaselines(w.stackBase())
+}
+
+// waterfallSpans returns the range each step's bar covers and the running total after each step.
+func waterfallSpans(steps []WaterfallStep) ([]barSpan, []float64) {
+	var spans []barSpan
+	var totals []float64
+	total := 0.0
+	for _, step := range steps {
+		switch step.Measure {
+		case WaterfallRelative:
+			spans = append(spans, barSpan{low: math.Min(total, total+step.Value), high: math.Max(total, total+step.Value)})
+			total += step.Value
+		case WaterfallAbsolute:
+			total = step.Value
+			fallthrough
+		default:
+			spans = append(spans, barSpan{low: math.Min(0, total), high: math.Max(0, total)})
+		}
+		totals = append(totals, total)
+	}
+	return spans, totals
+}
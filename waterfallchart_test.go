@@ -0,0 +1,57 @@
+package fynecharts
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"testing"
+)
+
+func TestWaterfallSpans(t *testing.T) {
+	spans, totals := waterfallSpans([]WaterfallStep{
+		{Value: 100, Measure: WaterfallAbsolute},
+		{Value: 60},
+		{Value: -25},
+		{Measure: WaterfallTotal},
+	})
+
+	expectedSpans := []barSpan{{0, 100}, {100, 160}, {135, 160}, {0, 135}}
+	expectedTotals := []float64{100, 160, 135, 135}
+	for idx := range expectedSpans {
+		if spans[idx] != expectedSpans[idx] {
+			t.Error("unexpected span", idx, spans[idx])
+		}
+		if totals[idx] != expectedTotals[idx] {
+			t.Error("unexpected total", idx, totals[idx])
+		}
+	}
+}
+
+func TestWaterfallIgnoresBarChartSetters(t *testing.T) {
+	w := NewWaterfallChart(nil, "", []WaterfallStep{{Label: "Open", Value: 100, Measure: WaterfallAbsolute}})
+	w.UpdateSeries([]string{"a"}, []BarSeries{{Values: []float64{1}}})
+	w.SetBaseline(50)
+	w.SetBarMode(BarModeStacked)
+
+	if w.series != nil || w.baseline != 0 || w.mode != BarModeGrouped {
+		t.Error("expected the bar chart setters to leave the waterfall unchanged")
+	}
+	if len(w.steps) != 1 || len(w.xLabels) != 1 || w.xLabels[0] != "Open" {
+		t.Error("expected the steps to be kept", w.steps, w.xLabels)
+	}
+}
+
+func TestWaterfallLayout(t *testing.T) {
+	test.NewApp()
+	w := NewWaterfallChart(nil, "", []WaterfallStep{
+		{Label: "Open", Value: 100, Measure: WaterfallAbsolute},
+		{Label: "Sales", Value: 60},
+		{Label: "Costs", Value: -25},
+	})
+	wr := test.WidgetRenderer(w).(*waterfallChartRenderer)
+	wr.Layout(fyne.NewSize(400, 300))
+
+	bars := wr.data[0]
+	if len(bars) != 3 || bars[1].Size().Height <= 0 || bars[1].Position().Y >= bars[0].Position().Y+bars[0].Size().Height {
+		t.Error("expected the steps to be laid out as floating bars")
+	}
+}